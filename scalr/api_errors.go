@@ -0,0 +1,245 @@
+package scalr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const requestIDHeader = "X-Request-Id"
+
+// apiError is a single JSON:API error object returned by the Scalr API.
+type apiError struct {
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	Source *struct {
+		Pointer string `json:"pointer"`
+	} `json:"source"`
+}
+
+// apiErrorResponse keeps the errors of a failed API response.
+type apiErrorResponse struct {
+	// message is the text of the error returned by go-scalr for this response.
+	message   string
+	requestID string
+	errors    []apiError
+}
+
+// apiErrorRecorder collects the failed API responses of the requests
+// made with the context it is attached to.
+type apiErrorRecorder struct {
+	mu        sync.Mutex
+	responses []*apiErrorResponse
+}
+
+type apiErrorRecorderKey struct{}
+
+// withAPIErrorRecorder returns a context that records the failed API responses.
+func withAPIErrorRecorder(ctx context.Context) (context.Context, *apiErrorRecorder) {
+	rec := &apiErrorRecorder{}
+	return context.WithValue(ctx, apiErrorRecorderKey{}, rec), rec
+}
+
+func (r *apiErrorRecorder) record(requestID string, body []byte) {
+	var payload struct {
+		Errors []apiError `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil || len(payload.Errors) == 0 {
+		return
+	}
+
+	// Format the message the same way go-scalr does.
+	messages := make([]string, 0, len(payload.Errors))
+	for _, e := range payload.Errors {
+		if e.Detail == "" {
+			messages = append(messages, e.Title)
+		} else {
+			messages = append(messages, fmt.Sprintf("%s\n\n%s", e.Title, e.Detail))
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.responses = append(r.responses, &apiErrorResponse{
+		message:   strings.Join(messages, "\n"),
+		requestID: requestID,
+		errors:    payload.Errors,
+	})
+}
+
+// lookup returns the most recent failed response whose error message
+// is a part of the given text.
+func (r *apiErrorRecorder) lookup(text string) *apiErrorResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(r.responses) - 1; i >= 0; i-- {
+		if resp := r.responses[i]; resp.message != "" && strings.Contains(text, resp.message) {
+			return resp
+		}
+	}
+	return nil
+}
+
+// translate replaces the error diagnostics caused by the recorded API errors
+// with diagnostics pointing to the attributes of the resource these errors are about.
+func (r *apiErrorRecorder) translate(diags diag.Diagnostics, s map[string]*schema.Schema) diag.Diagnostics {
+	r.mu.Lock()
+	recorded := len(r.responses)
+	r.mu.Unlock()
+	if len(diags) == 0 || recorded == 0 {
+		return diags
+	}
+
+	result := make(diag.Diagnostics, 0, len(diags))
+	for _, d := range diags {
+		if d.Severity != diag.Error || len(d.AttributePath) != 0 {
+			result = append(result, d)
+			continue
+		}
+
+		resp := r.lookup(d.Summary + "\n" + d.Detail)
+		if resp == nil {
+			result = append(result, d)
+			continue
+		}
+
+		summary := strings.TrimSpace(strings.Replace(d.Summary, resp.message, "", 1))
+		summary = strings.TrimSpace(strings.TrimSuffix(summary, ":"))
+		for _, e := range resp.errors {
+			detail := e.Title
+			if e.Detail != "" {
+				detail = fmt.Sprintf("%s\n\n%s", e.Title, e.Detail)
+			}
+			if resp.requestID != "" {
+				detail = fmt.Sprintf("%s\n\nRequest ID: %s", detail, resp.requestID)
+			}
+
+			translated := diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail:   detail,
+			}
+			if translated.Summary == "" {
+				translated.Summary = e.Title
+			}
+			if e.Source != nil {
+				translated.AttributePath = pointerToAttributePath(e.Source.Pointer, s)
+			}
+			result = append(result, translated)
+		}
+	}
+
+	return result
+}
+
+// pointerToAttributePath maps a JSON:API source pointer, such as /data/attributes/terraform-version
+// or /data/relationships/vcs-provider, to the path of the matching attribute of the schema.
+// The path stops at the deepest attribute that can be addressed; nil is returned if there is none.
+func pointerToAttributePath(pointer string, s map[string]*schema.Schema) cty.Path {
+	parts := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	if len(parts) < 3 || parts[0] != "data" {
+		return nil
+	}
+
+	switch parts[1] {
+	case "attributes":
+		var path cty.Path
+		for _, part := range parts[2:] {
+			key := strings.ReplaceAll(part, "-", "_")
+			attr, ok := s[key]
+			if !ok {
+				break
+			}
+			path = path.GetAttr(key)
+
+			block, ok := attr.Elem.(*schema.Resource)
+			if !ok || attr.Type != schema.TypeList {
+				break
+			}
+			path = path.IndexInt(0)
+			s = block.Schema
+		}
+		// Don't point into a nested block without the attribute.
+		if len(path) != 0 {
+			if _, ok := path[len(path)-1].(cty.IndexStep); ok {
+				path = path[:len(path)-1]
+			}
+		}
+		return path
+
+	case "relationships":
+		name := strings.ReplaceAll(parts[2], "-", "_")
+		for _, key := range []string{name + "_id", strings.TrimSuffix(name, "s") + "_ids", name} {
+			if _, ok := s[key]; ok {
+				return cty.GetAttrPath(key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// apiErrorsTransport passes the error responses of the Scalr API
+// to the recorder attached to the request context, if any.
+type apiErrorsTransport struct {
+	next http.RoundTripper
+}
+
+func newAPIErrorsTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &apiErrorsTransport{next: next}
+}
+
+func (t *apiErrorsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode < 400 {
+		return resp, err
+	}
+
+	rec, ok := req.Context().Value(apiErrorRecorderKey{}).(*apiErrorRecorder)
+	if !ok {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return resp, nil
+	}
+	rec.record(resp.Header.Get(requestIDHeader), body)
+
+	return resp, nil
+}
+
+// withAPIErrorDiagnostics wraps the CRUD functions of the resource to translate
+// the API errors they return into attribute-scoped diagnostics.
+func withAPIErrorDiagnostics(r *schema.Resource) *schema.Resource {
+	wrap := func(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if fn == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			ctx, rec := withAPIErrorRecorder(ctx)
+			return rec.translate(fn(ctx, d, meta), r.Schema)
+		}
+	}
+
+	r.CreateContext = wrap(r.CreateContext)
+	r.ReadContext = wrap(r.ReadContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+	r.DeleteContext = wrap(r.DeleteContext)
+
+	return r
+}
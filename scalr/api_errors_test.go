@@ -0,0 +1,124 @@
+package scalr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/scalr/go-scalr"
+)
+
+func TestPointerToAttributePath(t *testing.T) {
+	s := resourceScalrWorkspace().Schema
+
+	tests := map[string]struct {
+		pointer string
+		want    cty.Path
+	}{
+		"attribute": {
+			"/data/attributes/terraform-version",
+			cty.GetAttrPath("terraform_version"),
+		},
+		"nested attribute": {
+			"/data/attributes/vcs-repo/trigger-prefixes",
+			cty.GetAttrPath("vcs_repo").IndexInt(0).GetAttr("trigger_prefixes"),
+		},
+		"unknown nested attribute": {
+			"/data/attributes/vcs-repo/foo",
+			cty.GetAttrPath("vcs_repo"),
+		},
+		"to-one relationship": {
+			"/data/relationships/vcs-provider",
+			cty.GetAttrPath("vcs_provider_id"),
+		},
+		"to-many relationship": {
+			"/data/relationships/tags",
+			cty.GetAttrPath("tag_ids"),
+		},
+		"unknown attribute": {
+			"/data/attributes/foo",
+			nil,
+		},
+		"not a data pointer": {
+			"/included/0",
+			nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := pointerToAttributePath(tt.pointer, s)
+			if !got.Equals(tt.want) {
+				t.Fatalf("expected path %#v, got %#v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Header().Set(requestIDHeader, "req-123")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"errors": [
+  {"status": "422", "title": "Invalid Attribute", "detail": "Terraform version 0.0.1 is not supported", "source": {"pointer": "/data/attributes/terraform-version"}},
+  {"status": "422", "title": "Invalid Relationship", "source": {"pointer": "/data/relationships/vcs-provider"}}
+]}`))
+	}))
+	defer server.Close()
+
+	httpClient := scalr.DefaultConfig().HTTPClient
+	httpClient.Transport = newAPIErrorsTransport(httpClient.Transport)
+	client, err := scalr.NewClient(&scalr.Config{
+		Address:    server.URL,
+		Token:      "not-a-token",
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		t.Fatalf("error creating Scalr client: %v", err)
+	}
+
+	ctx, rec := withAPIErrorRecorder(context.Background())
+	_, err = client.Workspaces.Update(ctx, "ws-123", scalr.WorkspaceUpdateOptions{Name: scalr.String("test")})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	diags := rec.translate(
+		diag.Errorf("Error updating workspace ws-123: %v", err),
+		resourceScalrWorkspace().Schema,
+	)
+
+	expected := diag.Diagnostics{
+		{
+			Severity:      diag.Error,
+			Summary:       "Error updating workspace ws-123",
+			Detail:        "Invalid Attribute\n\nTerraform version 0.0.1 is not supported\n\nRequest ID: req-123",
+			AttributePath: cty.GetAttrPath("terraform_version"),
+		},
+		{
+			Severity:      diag.Error,
+			Summary:       "Error updating workspace ws-123",
+			Detail:        "Invalid Relationship\n\nRequest ID: req-123",
+			AttributePath: cty.GetAttrPath("vcs_provider_id"),
+		},
+	}
+	if len(diags) != len(expected) {
+		t.Fatalf("expected %d diagnostics, got %#v", len(expected), diags)
+	}
+	for i := range expected {
+		if diags[i].Summary != expected[i].Summary ||
+			diags[i].Detail != expected[i].Detail ||
+			!diags[i].AttributePath.Equals(expected[i].AttributePath) {
+			t.Fatalf("expected diagnostic %#v, got %#v", expected[i], diags[i])
+		}
+	}
+
+	unrelated := diag.Errorf("Error reading workspace ws-123: not found")
+	if got := rec.translate(unrelated, resourceScalrWorkspace().Schema); got[0].Summary != unrelated[0].Summary {
+		t.Fatalf("expected unrelated diagnostic to be left intact, got %#v", got)
+	}
+}
@@ -2,6 +2,8 @@ package scalr
 
 import (
 	"context"
+	"sort"
+	"sync"
 
 	"github.com/scalr/go-scalr"
 )
@@ -11,7 +13,9 @@ type workspaceNamesKey struct {
 }
 
 type mockWorkspaces struct {
+	mu             sync.Mutex
 	workspaceNames map[workspaceNamesKey]*scalr.Workspace
+	deleteErrors   map[string]error
 }
 
 type mockVariables struct {
@@ -21,6 +25,7 @@ type mockVariables struct {
 func newMockWorkspaces() *mockWorkspaces {
 	return &mockWorkspaces{
 		workspaceNames: make(map[workspaceNamesKey]*scalr.Workspace),
+		deleteErrors:   make(map[string]error),
 	}
 }
 
@@ -30,11 +35,31 @@ func newMockVariables() *mockVariables {
 	}
 }
 
-func (m *mockWorkspaces) List(_ context.Context, _ scalr.WorkspaceListOptions) (*scalr.WorkspaceList, error) {
-	panic("not implemented")
+func (m *mockWorkspaces) List(_ context.Context, options scalr.WorkspaceListOptions) (*scalr.WorkspaceList, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	wl := &scalr.WorkspaceList{
+		Pagination: &scalr.Pagination{CurrentPage: 1, TotalPages: 1},
+	}
+	for key, ws := range m.workspaceNames {
+		if options.Environment != nil && key.environment != *options.Environment {
+			continue
+		}
+		if options.Name != nil && key.workspace != *options.Name {
+			continue
+		}
+		wl.Items = append(wl.Items, ws)
+	}
+	sort.Slice(wl.Items, func(i, j int) bool { return wl.Items[i].ID < wl.Items[j].ID })
+
+	return wl, nil
 }
 
 func (m *mockWorkspaces) Create(_ context.Context, options scalr.WorkspaceCreateOptions) (*scalr.Workspace, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	ws := &scalr.Workspace{
 		ID:   options.ID,
 		Name: *options.Name,
@@ -50,7 +75,16 @@ func (m *mockWorkspaces) Create(_ context.Context, options scalr.WorkspaceCreate
 
 func (m *mockVariables) Create(_ context.Context, options scalr.VariableCreateOptions) (*scalr.Variable, error) {
 	variable := &scalr.Variable{
-		ID: options.ID,
+		ID:          options.ID,
+		Workspace:   options.Workspace,
+		Environment: options.Environment,
+		Account:     options.Account,
+	}
+	if options.Key != nil {
+		variable.Key = *options.Key
+	}
+	if options.Category != nil {
+		variable.Category = *options.Category
 	}
 
 	m.ids[options.ID] = variable
@@ -69,10 +103,21 @@ func (m *mockVariables) Read(_ context.Context, varID string) (*scalr.Variable,
 }
 
 func (m *mockVariables) List(_ context.Context, _ scalr.VariableListOptions) (*scalr.VariableList, error) {
-	panic("not implemented")
+	vl := &scalr.VariableList{
+		Pagination: &scalr.Pagination{CurrentPage: 1, TotalPages: 1},
+	}
+	for _, v := range m.ids {
+		vl.Items = append(vl.Items, v)
+	}
+	sort.Slice(vl.Items, func(i, j int) bool { return vl.Items[i].ID < vl.Items[j].ID })
+
+	return vl, nil
 }
 
 func (m *mockWorkspaces) Read(_ context.Context, environment string, workspace string) (*scalr.Workspace, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	w := m.workspaceNames[workspaceNamesKey{environment, workspace}]
 	if w == nil {
 		return nil, scalr.ErrResourceNotFound
@@ -89,8 +134,21 @@ func (m *mockWorkspaces) Update(_ context.Context, _ string, _ scalr.WorkspaceUp
 	panic("not implemented")
 }
 
-func (m *mockWorkspaces) Delete(_ context.Context, _ string) error {
-	panic("not implemented")
+func (m *mockWorkspaces) Delete(_ context.Context, workspaceID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.deleteErrors[workspaceID]; err != nil {
+		return err
+	}
+	for key, ws := range m.workspaceNames {
+		if ws.ID == workspaceID {
+			delete(m.workspaceNames, key)
+			return nil
+		}
+	}
+
+	return scalr.ErrResourceNotFound
 }
 
 func (m *mockWorkspaces) SetSchedule(_ context.Context, _ string, _ scalr.WorkspaceRunScheduleOptions) (*scalr.Workspace, error) {
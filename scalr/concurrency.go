@@ -0,0 +1,105 @@
+package scalr
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const defaultParallelism = 10
+
+// runConcurrently calls fn for every index in [0, n) running at most parallelism calls at a time.
+// The first failure cancels the context passed to the calls in flight and prevents
+// the remaining ones from being started. All errors returned by the calls are collected;
+// cancellation errors caused by the first failure are omitted.
+func runConcurrently(ctx context.Context, parallelism, n int, fn func(ctx context.Context, i int) error) []error {
+	if n == 0 {
+		return nil
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	sem := make(chan struct{}, parallelism)
+	for i := 0; i < n; i++ {
+		acquired := false
+		select {
+		case sem <- struct{}{}:
+			acquired = true
+		case <-runCtx.Done():
+		}
+		if runCtx.Err() != nil {
+			if acquired {
+				<-sem
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := fn(runCtx, i); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+				cancel()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// The parent context has been cancelled or timed out before any of the calls failed.
+	if len(errs) == 0 && ctx.Err() != nil {
+		return []error{ctx.Err()}
+	}
+
+	// Drop the errors of the calls interrupted because of the first failure.
+	var result []error
+	for _, err := range errs {
+		if ctx.Err() == nil && errors.Is(err, context.Canceled) {
+			continue
+		}
+		result = append(result, err)
+	}
+	if len(result) == 0 {
+		return errs
+	}
+
+	return result
+}
+
+// runTasksConcurrently runs the given tasks with the semantics of runConcurrently.
+func runTasksConcurrently(ctx context.Context, parallelism int, tasks []func(ctx context.Context) error) []error {
+	return runConcurrently(ctx, parallelism, len(tasks), func(ctx context.Context, i int) error {
+		return tasks[i](ctx)
+	})
+}
+
+// concurrencyDiagnostics converts the errors returned by runConcurrently
+// into diagnostics sharing the same summary.
+func concurrencyDiagnostics(summary string, errs []error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, err := range errs {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
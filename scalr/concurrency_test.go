@@ -0,0 +1,103 @@
+package scalr
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	t.Run("runs all calls within parallelism limit", func(t *testing.T) {
+		var running, maxRunning, calls int32
+		errs := runConcurrently(context.Background(), 3, 20, func(ctx context.Context, i int) error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			atomic.AddInt32(&calls, 1)
+			return nil
+		})
+
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+		if calls != 20 {
+			t.Fatalf("expected 20 calls, got %d", calls)
+		}
+		if maxRunning > 3 {
+			t.Fatalf("expected at most 3 concurrent calls, got %d", maxRunning)
+		}
+	})
+
+	t.Run("stops on first error", func(t *testing.T) {
+		var calls int32
+		errs := runConcurrently(context.Background(), 1, 10, func(ctx context.Context, i int) error {
+			atomic.AddInt32(&calls, 1)
+			if i == 2 {
+				return errors.New("boom")
+			}
+			return nil
+		})
+
+		if len(errs) != 1 || errs[0].Error() != "boom" {
+			t.Fatalf("expected single 'boom' error, got %v", errs)
+		}
+		if calls != 3 {
+			t.Fatalf("expected 3 calls before stopping, got %d", calls)
+		}
+	})
+
+	t.Run("cancels calls in flight and omits their cancellation errors", func(t *testing.T) {
+		errs := runConcurrently(context.Background(), 2, 2, func(ctx context.Context, i int) error {
+			if i == 0 {
+				return errors.New("boom")
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		if len(errs) != 1 || errs[0].Error() != "boom" {
+			t.Fatalf("expected single 'boom' error, got %v", errs)
+		}
+	})
+
+	t.Run("aggregates errors of concurrent calls", func(t *testing.T) {
+		var started sync.WaitGroup
+		started.Add(2)
+		errs := runConcurrently(context.Background(), 2, 2, func(ctx context.Context, i int) error {
+			started.Done()
+			started.Wait()
+			return errors.New("boom")
+		})
+
+		if len(errs) != 2 {
+			t.Fatalf("expected 2 errors, got %v", errs)
+		}
+	})
+
+	t.Run("honors parent context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var calls int32
+		errs := runConcurrently(ctx, 2, 5, func(ctx context.Context, i int) error {
+			atomic.AddInt32(&calls, 1)
+			return nil
+		})
+
+		if len(errs) != 1 || !errors.Is(errs[0], context.Canceled) {
+			t.Fatalf("expected context cancellation error, got %v", errs)
+		}
+		if calls != 0 {
+			t.Fatalf("expected no calls, got %d", calls)
+		}
+	})
+}
@@ -65,7 +65,7 @@ func dataSourceScalrAccessPolicy() *schema.Resource {
 }
 
 func dataSourceScalrAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Get("id").(string)
 
 	log.Printf("[DEBUG] Read configuration of access policy: %s", id)
@@ -23,15 +23,17 @@ func dataSourceScalrAgentPool() *schema.Resource {
 				Required: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 
 			"environment_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 
 			"workspace_ids": {
@@ -44,7 +46,7 @@ func dataSourceScalrAgentPool() *schema.Resource {
 }
 
 func dataSourceScalrAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	var envID string
 
 	name := d.Get("name").(string)
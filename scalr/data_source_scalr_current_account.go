@@ -26,7 +26,7 @@ func dataSourceScalrCurrentAccount() *schema.Resource {
 }
 
 func dataSourceScalrCurrentAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	accID, ok := getDefaultScalrAccountID()
 	if !ok {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,7 +44,10 @@ func dataSourceScalrCurrentRun() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
-						// TODO: add path
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"branch": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -70,7 +74,6 @@ func dataSourceScalrCurrentRun() *schema.Resource {
 													Type:     schema.TypeString,
 													Computed: true,
 												},
-												// TODO: add email and name
 											},
 										},
 									},
@@ -96,13 +99,20 @@ func dataSourceScalrCurrentRun() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
-			// TODO: add cost_estimate, credentials(?), created_by
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func dataSourceScalrCurrentRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	runID, exists := os.LookupEnv(currentRunIDEnvVar)
 	if !exists {
@@ -134,6 +144,8 @@ func dataSourceScalrCurrentRunRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("message", run.Message)
 	_ = d.Set("is_destroy", run.IsDestroy)
 	_ = d.Set("is_dry", run.Apply == nil)
+	_ = d.Set("status", run.Status)
+	_ = d.Set("created_at", run.CreatedAt.Format(time.RFC3339))
 
 	_ = d.Set("workspace_name", workspace.Name)
 	_ = d.Set("environment_id", workspace.Environment.ID)
@@ -143,6 +155,7 @@ func dataSourceScalrCurrentRunRead(ctx context.Context, d *schema.ResourceData,
 		var vcsConfig []map[string]interface{}
 		vcs := map[string]interface{}{
 			"repository_id": workspace.VCSRepo.Identifier,
+			"path":          workspace.WorkingDirectory,
 			"branch":        workspace.VCSRepo.Branch,
 			"commit":        []map[string]interface{}{},
 		}
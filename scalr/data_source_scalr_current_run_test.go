@@ -38,6 +38,10 @@ func TestAccCurrentRun_basic(t *testing.T) {
 						"data.scalr_current_run.test", "id"),
 					resource.TestCheckResourceAttr(
 						"data.scalr_current_run.test", "workspace_name", fmt.Sprintf("test-ws-%d", rInt)),
+					resource.TestCheckResourceAttrSet(
+						"data.scalr_current_run.test", "status"),
+					resource.TestCheckResourceAttrSet(
+						"data.scalr_current_run.test", "created_at"),
 				),
 			},
 		},
@@ -46,7 +50,7 @@ func TestAccCurrentRun_basic(t *testing.T) {
 
 func launchRun(environmentName, workspaceName string) func() {
 	return func() {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		options := GetEnvironmentByNameOptions{
 			Name: &environmentName,
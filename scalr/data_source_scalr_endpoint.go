@@ -52,10 +52,11 @@ func dataSourceScalrEndpoint() *schema.Resource {
 			},
 
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 
 			"environment_id": {
@@ -67,7 +68,7 @@ func dataSourceScalrEndpoint() *schema.Resource {
 }
 
 func dataSourceScalrEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get the ID
 	endpointID := d.Get("id").(string)
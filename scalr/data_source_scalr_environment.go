@@ -55,10 +55,11 @@ func dataSourceScalrEnvironment() *schema.Resource {
 				},
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 			"cloud_credentials": {
 				Type:     schema.TypeList,
@@ -79,7 +80,7 @@ func dataSourceScalrEnvironment() *schema.Resource {
 }
 
 func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	envID := d.Get("id").(string)
 	environmentName := d.Get("name").(string)
@@ -0,0 +1,192 @@
+package scalr
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scalr/go-scalr"
+)
+
+func dataSourceScalrEnvironments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalrEnvironmentsRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"tag_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTagID,
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{string(scalr.EnvironmentStatusActive), string(scalr.EnvironmentStatusInactive)},
+					false,
+				),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"environments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tag_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"policy_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"default_provider_configurations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalrEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scalrClient := meta.(*providerMeta).client
+
+	accountID := d.Get("account_id").(string)
+	nameRegex := d.Get("name_regex").(string)
+	status := d.Get("status").(string)
+
+	var nameRe *regexp.Regexp
+	if nameRegex != "" {
+		nameRe = regexp.MustCompile(nameRegex)
+	}
+
+	tagIDs := make([]string, 0)
+	if v, ok := d.GetOk("tag_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			tagIDs = append(tagIDs, id.(string))
+		}
+	}
+
+	options := scalr.EnvironmentListOptions{
+		Account: scalr.String(accountID),
+	}
+
+	ids := make([]string, 0)
+	environments := make([]map[string]interface{}, 0)
+
+	for {
+		el, err := scalrClient.Environments.List(ctx, options)
+		if err != nil {
+			return diag.Errorf("Error retrieving environments: %v", err)
+		}
+
+		for _, env := range el.Items {
+			if nameRe != nil && !nameRe.MatchString(env.Name) {
+				continue
+			}
+			if status != "" && string(env.Status) != status {
+				continue
+			}
+			if !environmentHasTags(env, tagIDs) {
+				continue
+			}
+
+			ids = append(ids, env.ID)
+			environments = append(environments, flattenEnvironment(env))
+		}
+
+		// Exit the loop when we've seen all pages.
+		if el.CurrentPage >= el.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = el.NextPage
+	}
+
+	_ = d.Set("ids", ids)
+	_ = d.Set("environments", environments)
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join(
+		append([]string{accountID, nameRegex, status}, tagIDs...), "|",
+	))))
+
+	return nil
+}
+
+// environmentHasTags checks whether the environment is tagged with all of the given tags.
+func environmentHasTags(env *scalr.Environment, tagIDs []string) bool {
+	envTags := make(map[string]bool, len(env.Tags))
+	for _, tag := range env.Tags {
+		envTags[tag.ID] = true
+	}
+	for _, id := range tagIDs {
+		if !envTags[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func flattenEnvironment(env *scalr.Environment) map[string]interface{} {
+	tagIDs := make([]string, 0, len(env.Tags))
+	for _, tag := range env.Tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	policyGroups := make([]string, 0, len(env.PolicyGroups))
+	for _, group := range env.PolicyGroups {
+		policyGroups = append(policyGroups, group.ID)
+	}
+	defaultProviderConfigurations := make([]string, 0, len(env.DefaultProviderConfigurations))
+	for _, pcfg := range env.DefaultProviderConfigurations {
+		defaultProviderConfigurations = append(defaultProviderConfigurations, pcfg.ID)
+	}
+
+	return map[string]interface{}{
+		"id":                              env.ID,
+		"name":                            env.Name,
+		"status":                          string(env.Status),
+		"tag_ids":                         tagIDs,
+		"policy_groups":                   policyGroups,
+		"default_provider_configurations": defaultProviderConfigurations,
+	}
+}
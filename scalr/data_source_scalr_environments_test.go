@@ -0,0 +1,88 @@
+package scalr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalrEnvironmentsDataSource(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrEnvironmentsDataSourceInitConfig(rInt), // depends_on works improperly with data sources
+			},
+			{
+				Config: testAccScalrEnvironmentsDataSourceConfig(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scalr_environments.by_name", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.scalr_environments.by_name", "environments.#", "2"),
+					resource.TestCheckResourceAttr("data.scalr_environments.by_tag", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_environments.by_tag", "ids.0",
+						"scalr_environment.prod", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_environments.by_tag", "environments.0.id",
+						"scalr_environment.prod", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.scalr_environments.by_tag", "environments.0.name", fmt.Sprintf("test-env-prod-%d", rInt)),
+					resource.TestCheckResourceAttr("data.scalr_environments.by_tag", "environments.0.status", "Active"),
+					resource.TestCheckResourceAttr("data.scalr_environments.by_tag", "environments.0.tag_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_environments.by_tag", "environments.0.tag_ids.0",
+						"scalr_tag.prod", "id",
+					),
+					resource.TestCheckResourceAttr("data.scalr_environments.inactive", "ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccScalrEnvironmentsDataSourceInitConfig(rInt), // depends_on works improperly with data sources
+			},
+		},
+	})
+}
+
+func testAccScalrEnvironmentsDataSourceInitConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_tag" "prod" {
+  name       = "test-tag-prod-%[1]d"
+  account_id = "%[2]s"
+}
+
+resource "scalr_environment" "prod" {
+  name       = "test-env-prod-%[1]d"
+  account_id = "%[2]s"
+  tag_ids    = [scalr_tag.prod.id]
+}
+
+resource "scalr_environment" "dev" {
+  name       = "test-env-dev-%[1]d"
+  account_id = "%[2]s"
+}`, rInt, defaultAccount)
+}
+
+func testAccScalrEnvironmentsDataSourceConfig(rInt int) string {
+	return testAccScalrEnvironmentsDataSourceInitConfig(rInt) + fmt.Sprintf(`
+data "scalr_environments" "by_name" {
+  name_regex = "^test-env-(prod|dev)-%[1]d$"
+  account_id = "%[2]s"
+}
+
+data "scalr_environments" "by_tag" {
+  tag_ids    = [scalr_tag.prod.id]
+  account_id = "%[2]s"
+}
+
+data "scalr_environments" "inactive" {
+  name_regex = "^test-env-(prod|dev)-%[1]d$"
+  status     = "Inactive"
+  account_id = "%[2]s"
+}`, rInt, defaultAccount)
+}
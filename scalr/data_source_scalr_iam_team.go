@@ -25,10 +25,11 @@ func dataSourceScalrIamTeam() *schema.Resource {
 				Computed: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 			"identity_provider_id": {
 				Type:     schema.TypeString,
@@ -44,7 +45,7 @@ func dataSourceScalrIamTeam() *schema.Resource {
 }
 
 func dataSourceScalrIamTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// required fields
 	name := d.Get("name").(string)
@@ -49,7 +49,7 @@ func dataSourceScalrIamUser() *schema.Resource {
 }
 
 func dataSourceScalrIamUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// required fields
 	email := d.Get("email").(string)
@@ -30,7 +30,7 @@ func dataSourceModuleVersion() *schema.Resource {
 }
 
 func dataSourceModuleVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	source := d.Get("source").(string)
 	module, err := scalrClient.Modules.ReadBySource(ctx, source)
@@ -57,7 +57,7 @@ func TestAccModuleVersionDataSource_basic(t *testing.T) {
 
 func waitForModuleVersions(environmentName string) func() {
 	return func() {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		options := GetEnvironmentByNameOptions{
 			Name: &environmentName,
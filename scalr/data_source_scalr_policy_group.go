@@ -55,10 +55,11 @@ func dataSourceScalrPolicyGroup() *schema.Resource {
 				},
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 			"vcs_provider_id": {
 				Type:     schema.TypeString,
@@ -94,7 +95,7 @@ func dataSourceScalrPolicyGroup() *schema.Resource {
 }
 
 func dataSourceScalrPolicyGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// required fields
 	name := d.Get("name").(string)
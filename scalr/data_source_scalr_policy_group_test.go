@@ -88,7 +88,7 @@ func TestAccPolicyGroupDataSource_basic(t *testing.T) {
 
 func waitForPolicyGroupFetch(name string) func() {
 	return func() {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		pgl, err := scalrClient.PolicyGroups.List(ctx, scalr.PolicyGroupListOptions{
 			Account: defaultAccount,
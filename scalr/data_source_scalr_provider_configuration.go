@@ -19,10 +19,11 @@ func dataSourceScalrProviderConfiguration() *schema.Resource {
 				Computed: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -37,7 +38,7 @@ func dataSourceScalrProviderConfiguration() *schema.Resource {
 }
 
 func dataSourceScalrProviderConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	accountID := d.Get("account_id").(string)
 	name := d.Get("name").(string)
@@ -3,9 +3,12 @@ package scalr
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/scalr/go-scalr"
 )
@@ -20,29 +23,161 @@ func dataSourceScalrProviderConfigurations() *schema.Resource {
 				Computed: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 			"name": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
 			"provider_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"environment_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnvironmentID,
+			},
+			"credentials_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{"access_keys", "role_delegation"},
+					false,
+				),
+			},
+			"provider_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_shared": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"environments": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"export_shell_variables": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"aws": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"credentials_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"trusted_entity_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"role_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"access_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"google": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"project": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"azurerm": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"subscription_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tenant_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"scalr": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hostname": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func dataSourceScalrProviderConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	accountID := d.Get("account_id").(string)
 	name := d.Get("name").(string)
+	nameRegex := d.Get("name_regex").(string)
 	providerName := d.Get("provider_name").(string)
+	environmentID := d.Get("environment_id").(string)
+	credentialsType := d.Get("credentials_type").(string)
+
+	var nameRe *regexp.Regexp
+	if nameRegex != "" {
+		nameRe = regexp.MustCompile(nameRegex)
+	}
 
 	providersFilter := scalr.ProviderConfigurationFilter{
 		AccountID:    accountID,
@@ -53,29 +188,102 @@ func dataSourceScalrProviderConfigurationsRead(ctx context.Context, d *schema.Re
 		Filter: &providersFilter,
 	}
 
-	var ids []string
+	ids := make([]string, 0)
+	providerConfigurations := make([]map[string]interface{}, 0)
 
 	for {
-		providerConfigurations, err := scalrClient.ProviderConfigurations.List(ctx, options)
+		pcfgl, err := scalrClient.ProviderConfigurations.List(ctx, options)
 		if err != nil {
 			return diag.Errorf("Error retrieving provider configuration: %v", err)
 		}
 
-		for _, providerConfiguration := range providerConfigurations.Items {
+		for _, providerConfiguration := range pcfgl.Items {
+			if nameRe != nil && !nameRe.MatchString(providerConfiguration.Name) {
+				continue
+			}
+			if environmentID != "" && !providerConfigurationSharedWith(providerConfiguration, environmentID) {
+				continue
+			}
+			if credentialsType != "" && providerConfiguration.AwsCredentialsType != credentialsType {
+				continue
+			}
+
 			ids = append(ids, providerConfiguration.ID)
+			providerConfigurations = append(providerConfigurations, flattenProviderConfiguration(providerConfiguration))
 		}
 
 		// Exit the loop when we've seen all pages.
-		if providerConfigurations.CurrentPage >= providerConfigurations.TotalPages {
+		if pcfgl.CurrentPage >= pcfgl.TotalPages {
 			break
 		}
 
 		// Update the page number to get the next page.
-		options.PageNumber = providerConfigurations.NextPage
+		options.PageNumber = pcfgl.NextPage
 	}
 
 	_ = d.Set("ids", ids)
-	d.SetId(fmt.Sprintf("%d", schema.HashString(accountID+name+providerName)))
+	_ = d.Set("provider_configurations", providerConfigurations)
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join(
+		[]string{accountID, name, nameRegex, providerName, environmentID, credentialsType}, "|",
+	))))
 
 	return nil
 }
+
+// providerConfigurationSharedWith checks whether the provider configuration
+// is available in the environment, either explicitly or by being shared with all environments.
+func providerConfigurationSharedWith(pcfg *scalr.ProviderConfiguration, environmentID string) bool {
+	if pcfg.IsShared {
+		return true
+	}
+	for _, env := range pcfg.Environments {
+		if env.ID == environmentID {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenProviderConfiguration returns the non-secret attributes of the provider configuration.
+func flattenProviderConfiguration(pcfg *scalr.ProviderConfiguration) map[string]interface{} {
+	environments := make([]string, 0, len(pcfg.Environments))
+	for _, env := range pcfg.Environments {
+		environments = append(environments, env.ID)
+	}
+
+	result := map[string]interface{}{
+		"id":                     pcfg.ID,
+		"name":                   pcfg.Name,
+		"provider_name":          pcfg.ProviderName,
+		"is_shared":              pcfg.IsShared,
+		"environments":           environments,
+		"export_shell_variables": pcfg.ExportShellVariables,
+	}
+
+	switch pcfg.ProviderName {
+	case "aws":
+		result["aws"] = []map[string]interface{}{{
+			"account_type":        pcfg.AwsAccountType,
+			"credentials_type":    pcfg.AwsCredentialsType,
+			"trusted_entity_type": pcfg.AwsTrustedEntityType,
+			"role_arn":            pcfg.AwsRoleArn,
+			"access_key":          pcfg.AwsAccessKey,
+		}}
+	case "google":
+		result["google"] = []map[string]interface{}{{
+			"project": pcfg.GoogleProject,
+		}}
+	case "azurerm":
+		result["azurerm"] = []map[string]interface{}{{
+			"client_id":       pcfg.AzurermClientId,
+			"subscription_id": pcfg.AzurermSubscriptionId,
+			"tenant_id":       pcfg.AzurermTenantId,
+		}}
+	case "scalr":
+		result["scalr"] = []map[string]interface{}{{
+			"hostname": pcfg.ScalrHostname,
+		}}
+	}
+
+	return result
+}
@@ -21,6 +21,24 @@ func TestAccScalrProviderConfigurationsDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckProviderConfigurationsDataSourceNameFilter(),
 					testAccCheckProviderConfigurationsDataSourceTypeFilter(),
+					resource.TestCheckResourceAttr("data.scalr_provider_configurations.consul_regex", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_provider_configurations.consul_regex", "ids.0",
+						"scalr_provider_configuration.consul", "id",
+					),
+					resource.TestCheckResourceAttr("data.scalr_provider_configurations.consul_regex", "provider_configurations.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.scalr_provider_configurations.consul_regex", "provider_configurations.0.id",
+						"scalr_provider_configuration.consul", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.scalr_provider_configurations.consul_regex", "provider_configurations.0.name", "consul"),
+					resource.TestCheckResourceAttr(
+						"data.scalr_provider_configurations.consul_regex", "provider_configurations.0.provider_name", "consul"),
+					resource.TestCheckResourceAttr(
+						"data.scalr_provider_configurations.consul_regex", "provider_configurations.0.is_shared", "false"),
+					resource.TestCheckResourceAttr(
+						"data.scalr_provider_configurations.kubernetes", "provider_configurations.#", "2"),
 				),
 			},
 			{
@@ -161,4 +179,7 @@ data "scalr_provider_configurations" "kubernetes2consul" {
 data "scalr_provider_configurations" "kubernetes" {
   provider_name = "kubernetes"
 }
+data "scalr_provider_configurations" "consul_regex" {
+  name_regex = "^cons"
+}
 `
@@ -23,10 +23,11 @@ func dataSourceScalrRole() *schema.Resource {
 				Required: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 
 			"is_system": {
@@ -49,7 +50,7 @@ func dataSourceScalrRole() *schema.Resource {
 }
 
 func dataSourceScalrRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// required fields
 	name := d.Get("name").(string)
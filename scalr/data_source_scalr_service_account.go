@@ -37,10 +37,11 @@ func dataSourceScalrServiceAccount() *schema.Resource {
 				Computed: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 			"created_by": {
 				Type:     schema.TypeList,
@@ -67,7 +68,7 @@ func dataSourceScalrServiceAccount() *schema.Resource {
 }
 
 func dataSourceScalrServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	saID := d.Get("id").(string)
 	email := d.Get("email").(string)
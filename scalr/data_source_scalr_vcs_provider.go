@@ -31,14 +31,16 @@ func dataSourceScalrVcsProvider() *schema.Resource {
 				Optional: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 			"environment_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 			"environments": {
 				Type:     schema.TypeList,
@@ -49,7 +51,7 @@ func dataSourceScalrVcsProvider() *schema.Resource {
 }
 
 func dataSourceScalrVcsProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	options := scalr.VcsProvidersListOptions{
 		Account: scalr.String(d.Get("account_id").(string)),
 	}
@@ -52,29 +52,32 @@ func dataSourceScalrWebhook() *schema.Resource {
 			},
 
 			"account_id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 
 			"environment_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Optional: true,
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 
 			"workspace_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Optional: true,
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validateWorkspaceID,
 			},
 		},
 	}
 }
 
 func dataSourceScalrWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get IDs
 	webhookID := d.Get("id").(string)
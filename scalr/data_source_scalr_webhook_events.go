@@ -0,0 +1,36 @@
+package scalr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceScalrWebhookEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalrWebhookEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceScalrWebhookEventsRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The event definitions are not exposed by the API client yet, so this returns
+	// the provider's built-in catalog the webhook events are validated against,
+	// not the list of the events supported by the server.
+	ids := knownEventDefinitions()
+
+	_ = d.Set("ids", ids)
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join(ids, "|"))))
+
+	return nil
+}
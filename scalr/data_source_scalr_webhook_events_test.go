@@ -0,0 +1,25 @@
+package scalr
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalrWebhookEventsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data scalr_webhook_events test {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scalr_webhook_events.test", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.scalr_webhook_events.test", "ids.0", "run:completed"),
+					resource.TestCheckResourceAttr("data.scalr_webhook_events.test", "ids.1", "run:errored"),
+					resource.TestCheckResourceAttr("data.scalr_webhook_events.test", "ids.2", "run:needs_attention"),
+				),
+			},
+		},
+	})
+}
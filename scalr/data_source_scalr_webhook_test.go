@@ -142,7 +142,7 @@ func testAccWebhookDataSourceNotFoundByNameConfig() string {
 	return `
 data scalr_webhook test {
   name       = "webhook-foo-bar-baz"
-  account_id = "foobar"
+  account_id = "acc-foobar"
 }`
 }
 
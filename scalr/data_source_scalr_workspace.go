@@ -21,22 +21,26 @@ func dataSourceScalrWorkspace() *schema.Resource {
 			},
 
 			"environment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 
 			"vcs_provider_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateVcsProviderID,
 			},
 			"module_version_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateModuleVersionID,
 			},
 
 			"agent_pool_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAgentPoolID,
 			},
 			"auto_apply": {
 				Type:     schema.TypeBool,
@@ -166,7 +170,7 @@ func dataSourceScalrWorkspace() *schema.Resource {
 }
 
 func dataSourceScalrWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get the name and environment_id.
 	name := d.Get("name").(string)
@@ -21,8 +21,9 @@ func dataSourceScalrWorkspaceIDs() *schema.Resource {
 			},
 
 			"environment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 
 			"ids": {
@@ -34,7 +35,7 @@ func dataSourceScalrWorkspaceIDs() *schema.Resource {
 }
 
 func dataSourceScalrWorkspaceIDsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get the environment_id.
 	environmentID := d.Get("environment_id").(string)
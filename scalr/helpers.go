@@ -8,6 +8,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/scalr/go-scalr"
 )
 
@@ -182,3 +183,14 @@ func scalrAccountIDDefaultFunc() (interface{}, error) {
 		"\nIf you are using Scalr Provider for local runs, please set the attribute in resources explicitly," +
 		"\nor export `SCALR_ACCOUNT_ID` environment variable prior the run.")
 }
+
+// isExplicitlyEmpty reports whether the attribute or block is set to an empty collection
+// in the configuration, as opposed to being omitted.
+func isExplicitlyEmpty(rawConfig cty.Value, name string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	v := rawConfig.GetAttr(name)
+	return !v.IsNull() && v.IsKnown() && v.LengthInt() == 0
+}
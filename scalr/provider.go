@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	svchost "github.com/hashicorp/terraform-svchost"
 	"github.com/hashicorp/terraform-svchost/auth"
 	"github.com/hashicorp/terraform-svchost/disco"
@@ -41,7 +42,7 @@ type ConfigHost struct {
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"hostname": {
 				Type:        schema.TypeString,
@@ -56,6 +57,14 @@ func Provider() *schema.Provider {
 				Description: "Scalr API token.",
 				DefaultFunc: schema.EnvDefaultFunc("SCALR_TOKEN", nil),
 			},
+
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  fmt.Sprintf("The maximum number of concurrent API calls made by bulk operations. Defaults to %d.", defaultParallelism),
+				DefaultFunc:  schema.EnvDefaultFunc("SCALR_PARALLELISM", defaultParallelism),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -65,6 +74,7 @@ func Provider() *schema.Provider {
 			"scalr_current_run":             dataSourceScalrCurrentRun(),
 			"scalr_endpoint":                dataSourceScalrEndpoint(),
 			"scalr_environment":             dataSourceScalrEnvironment(),
+			"scalr_environments":            dataSourceScalrEnvironments(),
 			"scalr_iam_team":                dataSourceScalrIamTeam(),
 			"scalr_iam_user":                dataSourceScalrIamUser(),
 			"scalr_module_version":          dataSourceModuleVersion(),
@@ -78,37 +88,57 @@ func Provider() *schema.Provider {
 			"scalr_variables":               dataSourceScalrVariables(),
 			"scalr_vcs_provider":            dataSourceScalrVcsProvider(),
 			"scalr_webhook":                 dataSourceScalrWebhook(),
+			"scalr_webhook_events":          dataSourceScalrWebhookEvents(),
 			"scalr_workspace":               dataSourceScalrWorkspace(),
 			"scalr_workspace_ids":           dataSourceScalrWorkspaceIDs(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"scalr_access_policy":                  resourceScalrAccessPolicy(),
-			"scalr_account_allowed_ips":            resourceScalrAccountAllowedIps(),
-			"scalr_agent_pool":                     resourceScalrAgentPool(),
-			"scalr_agent_pool_token":               resourceScalrAgentPoolToken(),
-			"scalr_endpoint":                       resourceScalrEndpoint(),
-			"scalr_environment":                    resourceScalrEnvironment(),
-			"scalr_iam_team":                       resourceScalrIamTeam(),
-			"scalr_module":                         resourceScalrModule(),
-			"scalr_policy_group":                   resourceScalrPolicyGroup(),
-			"scalr_policy_group_linkage":           resourceScalrPolicyGroupLinkage(),
-			"scalr_provider_configuration":         resourceScalrProviderConfiguration(),
-			"scalr_provider_configuration_default": resourceScalrProviderConfigurationDefault(),
-			"scalr_role":                           resourceScalrRole(),
-			"scalr_run_trigger":                    resourceScalrRunTrigger(),
-			"scalr_service_account":                resourceScalrServiceAccount(),
-			"scalr_service_account_token":          resourceScalrServiceAccountToken(),
-			"scalr_tag":                            resourceScalrTag(),
-			"scalr_variable":                       resourceScalrVariable(),
-			"scalr_vcs_provider":                   resourceScalrVcsProvider(),
-			"scalr_webhook":                        resourceScalrWebhook(),
-			"scalr_workspace":                      resourceScalrWorkspace(),
-			"scalr_workspace_run_schedule":         resourceScalrWorkspaceRunSchedule(),
+			"scalr_access_policy":                               resourceScalrAccessPolicy(),
+			"scalr_account_allowed_ips":                         resourceScalrAccountAllowedIps(),
+			"scalr_agent_pool":                                  resourceScalrAgentPool(),
+			"scalr_agent_pool_token":                            resourceScalrAgentPoolToken(),
+			"scalr_endpoint":                                    resourceScalrEndpoint(),
+			"scalr_environment":                                 resourceScalrEnvironment(),
+			"scalr_environment_provider_configuration_defaults": resourceScalrEnvironmentProviderConfigurationDefaults(),
+			"scalr_iam_team":                                    resourceScalrIamTeam(),
+			"scalr_module":                                      resourceScalrModule(),
+			"scalr_policy_group":                                resourceScalrPolicyGroup(),
+			"scalr_policy_group_linkage":                        resourceScalrPolicyGroupLinkage(),
+			"scalr_provider_configuration":                      resourceScalrProviderConfiguration(),
+			"scalr_provider_configuration_default":              resourceScalrProviderConfigurationDefault(),
+			"scalr_provider_configuration_link":                 resourceScalrProviderConfigurationLink(),
+			"scalr_role":                                        resourceScalrRole(),
+			"scalr_run_trigger":                                 resourceScalrRunTrigger(),
+			"scalr_service_account":                             resourceScalrServiceAccount(),
+			"scalr_service_account_token":                       resourceScalrServiceAccountToken(),
+			"scalr_tag":                                         resourceScalrTag(),
+			"scalr_variable":                                    resourceScalrVariable(),
+			"scalr_vcs_provider":                                resourceScalrVcsProvider(),
+			"scalr_webhook":                                     resourceScalrWebhook(),
+			"scalr_workspace":                                   resourceScalrWorkspace(),
+			"scalr_workspace_run_schedule":                      resourceScalrWorkspaceRunSchedule(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	// Point the API errors to the attributes they are about.
+	for _, r := range p.DataSourcesMap {
+		withAPIErrorDiagnostics(r)
+	}
+	for name, r := range p.ResourcesMap {
+		withTimeouts(name, withAPIErrorDiagnostics(r))
+	}
+
+	return p
+}
+
+// providerMeta is passed as meta to the functions of resources and data sources.
+type providerMeta struct {
+	client *scalr.Client
+	// parallelism is the number of concurrent API calls allowed for bulk operations.
+	parallelism int
 }
 
 func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -192,7 +222,7 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 	}
 
 	httpClient := scalr.DefaultConfig().HTTPClient
-	httpClient.Transport = logging.NewLoggingHTTPTransport(httpClient.Transport)
+	httpClient.Transport = newAPIErrorsTransport(logging.NewLoggingHTTPTransport(httpClient.Transport))
 
 	headers := make(http.Header)
 	headers.Add("User-Agent", providerUaString)
@@ -212,7 +242,11 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 	}
 
 	client.RetryServerErrors(true)
-	return client, nil
+
+	return &providerMeta{
+		client:      client,
+		parallelism: d.Get("parallelism").(int),
+	}, nil
 }
 
 // cliConfig tries to find and parse the configuration of the Terraform CLI.
@@ -42,6 +42,21 @@ func (s Subject) IsValid() error {
 	return errors.New("Invalid subject type")
 }
 
+// accessPolicySubjectIDValidators and accessPolicyScopeIDValidators check
+// the subject and scope IDs against the kind of object set by their type.
+var (
+	accessPolicySubjectIDValidators = map[Subject]schema.SchemaValidateFunc{
+		User:           validateUserID,
+		Team:           validateTeamID,
+		ServiceAccount: validateServiceAccountID,
+	}
+	accessPolicyScopeIDValidators = map[Scope]schema.SchemaValidateFunc{
+		Workspace:   validateWorkspaceID,
+		Environment: validateEnvironmentID,
+		Account:     validateAccountID,
+	}
+)
+
 func resourceScalrAccessPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceScalrAccessPolicyCreate,
@@ -51,6 +66,7 @@ func resourceScalrAccessPolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceScalrAccessPolicyCustomizeDiff,
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"is_system": {
@@ -113,7 +129,10 @@ func resourceScalrAccessPolicy() *schema.Resource {
 				Required: true,
 				MinItems: 1,
 				MaxItems: 128,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateRoleID,
+				},
 			},
 		},
 	}
@@ -135,8 +154,25 @@ func parseRoleIdDefinitions(d *schema.ResourceData) ([]*scalr.Role, error) {
 	return roles, nil
 }
 
+// resourceScalrAccessPolicyCustomizeDiff checks that the subject and scope IDs
+// are the IDs of the kind of objects set by their types.
+func resourceScalrAccessPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if validate, ok := accessPolicySubjectIDValidators[Subject(d.Get("subject.0.type").(string))]; ok {
+		if _, errs := validate(d.Get("subject.0.id"), "subject.0.id"); len(errs) != 0 {
+			return errs[0]
+		}
+	}
+	if validate, ok := accessPolicyScopeIDValidators[Scope(d.Get("scope.0.type").(string))]; ok {
+		if _, errs := validate(d.Get("scope.0.id"), "scope.0.id"); len(errs) != 0 {
+			return errs[0]
+		}
+	}
+
+	return nil
+}
+
 func resourceScalrAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	subject := d.Get("subject").([]interface{})[0].(map[string]interface{})
 	subjectType := subject["type"].(string)
@@ -183,7 +219,7 @@ func resourceScalrAccessPolicyCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceScalrAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Read configuration of access policy: %s", id)
@@ -247,7 +283,7 @@ func resourceScalrAccessPolicyRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -272,7 +308,7 @@ func resourceScalrAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceScalrAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete access policy %s", id)
@@ -70,6 +70,23 @@ func TestAccScalrAccessPolicy_bad_subject(t *testing.T) {
 	})
 }
 
+func TestAccScalrAccessPolicy_mismatched_ids(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScalrAccessPolicyMismatchedIDs("team", defaultAccount, "account", defaultAccount),
+				ExpectError: regexp.MustCompile("expected subject.0.id to be the ID of a team"),
+			},
+			{
+				Config:      testAccScalrAccessPolicyMismatchedIDs("user", testUser, "workspace", defaultAccount),
+				ExpectError: regexp.MustCompile("expected scope.0.id to be the ID of a workspace"),
+			},
+		},
+	})
+}
+
 func TestAccScalrAccessPolicy_changed_outside(t *testing.T) {
 	ap := &scalr.AccessPolicy{}
 	rInt := rand.New(rand.NewSource(time.Now().UnixNano())).Int()
@@ -177,7 +194,7 @@ func TestAccScalrAccessPolicy_import(t *testing.T) {
 
 func testAccCheckScalrAccessPolicyExists(resId string, ap *scalr.AccessPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -202,7 +219,7 @@ func testAccCheckScalrAccessPolicyExists(resId string, ap *scalr.AccessPolicy) r
 
 func testAccCheckScalrAccessPolicyChangedOutside(ap *scalr.AccessPolicy) func() {
 	return func() {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		r, err := scalrClient.AccessPolicies.Read(ctx, ap.ID)
 
@@ -226,7 +243,7 @@ func testAccCheckScalrAccessPolicyChangedOutside(ap *scalr.AccessPolicy) func()
 }
 
 func testAccCheckScalrAccessPolicyDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_access_policy" {
@@ -305,6 +322,25 @@ resource "scalr_access_policy" "test" {
 `, testUser, defaultAccount, readOnlyRole)
 }
 
+func testAccScalrAccessPolicyMismatchedIDs(subjectType, subjectID, scopeType, scopeID string) string {
+	return fmt.Sprintf(`
+resource "scalr_access_policy" "test" {
+  subject {
+    type = "%s"
+    id = "%s"
+  }
+  scope {
+    type = "%s"
+    id = "%s"
+  }
+  role_ids = [
+    "%s"
+  ]
+}
+
+`, subjectType, subjectID, scopeType, scopeID, readOnlyRole)
+}
+
 func testAccScalrAccessPolicyBasic(rInt int) string {
 	return fmt.Sprintf(iamPolicyTemplate, rInt, defaultAccount, testUser, readOnlyRole)
 }
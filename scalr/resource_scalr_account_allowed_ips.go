@@ -25,10 +25,11 @@ func resourceScalrAccountAllowedIps() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ValidateFunc: validateAccountID,
 			},
 
 			"allowed_ips": {
@@ -51,7 +52,7 @@ func preprocessAllowedIps(allowedIps []interface{}) []string {
 }
 
 func resourceScalrAccountAllowedIpsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get attributes.
 	accountId := d.Get("account_id").(string)
@@ -75,7 +76,7 @@ func resourceScalrAccountAllowedIpsCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceScalrAccountAllowedIpsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get the ID
 	accountID := d.Id()
@@ -105,7 +106,7 @@ func resourceScalrAccountAllowedIpsRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceScalrAccountAllowedIpsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get attributes.
 	allowedIps := preprocessAllowedIps(d.Get("allowed_ips").([]interface{}))
@@ -126,7 +127,7 @@ func resourceScalrAccountAllowedIpsUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceScalrAccountAllowedIpsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Delete allowed ips for account: %s", d.Id())
 
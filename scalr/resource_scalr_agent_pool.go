@@ -26,24 +26,26 @@ func resourceScalrAgentPool() *schema.Resource {
 				Required: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ForceNew:     true,
+				ValidateFunc: validateAccountID,
 			},
 
 			"environment_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 		},
 	}
 }
 
 func resourceScalrAgentPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	var envID string
 
 	// Get required options
@@ -73,7 +75,7 @@ func resourceScalrAgentPoolCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScalrAgentPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of agent pool: %s", id)
 	agentPool, err := scalrClient.AgentPools.Read(ctx, id)
@@ -99,7 +101,7 @@ func resourceScalrAgentPoolRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScalrAgentPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -121,7 +123,7 @@ func resourceScalrAgentPoolUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScalrAgentPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete agent pool %s", id)
@@ -119,7 +119,7 @@ func TestAccScalrAgentPool_import(t *testing.T) {
 
 func testAccCheckScalrAgentPoolExists(resId string, pool *scalr.AgentPool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -144,7 +144,7 @@ func testAccCheckScalrAgentPoolExists(resId string, pool *scalr.AgentPool) resou
 
 func testAccCheckScalrAgentPoolRename(pool *scalr.AgentPool) func() {
 	return func() {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		r, err := scalrClient.AgentPools.Read(ctx, pool.ID)
 
@@ -168,7 +168,7 @@ func testAccCheckScalrAgentPoolRename(pool *scalr.AgentPool) func() {
 }
 
 func testAccCheckScalrAgentPoolDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_agent_pool" {
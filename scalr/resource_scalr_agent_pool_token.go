@@ -23,9 +23,10 @@ func resourceScalrAgentPoolToken() *schema.Resource {
 				Required: true,
 			},
 			"agent_pool_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAgentPoolID,
 			},
 			"token": {
 				Type:      schema.TypeString,
@@ -37,7 +38,7 @@ func resourceScalrAgentPoolToken() *schema.Resource {
 }
 
 func resourceScalrAgentPoolTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get required options
 	poolID := d.Get("agent_pool_id").(string)
@@ -64,7 +65,7 @@ func resourceScalrAgentPoolTokenCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceScalrAgentPoolTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 	poolID := d.Get("agent_pool_id").(string)
 
@@ -110,7 +111,7 @@ func resourceScalrAgentPoolTokenRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceScalrAgentPoolTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -133,7 +134,7 @@ func resourceScalrAgentPoolTokenUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceScalrAgentPoolTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete agent pool token %s", id)
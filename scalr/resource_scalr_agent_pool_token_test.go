@@ -106,7 +106,7 @@ func TestAccScalrAgentPoolToken_update(t *testing.T) {
 
 func testAccCheckScalrAgentPoolTokenExists(resId string, pool scalr.AgentPool, token *scalr.AccessToken) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -138,7 +138,7 @@ func testAccCheckScalrAgentPoolTokenExists(resId string, pool scalr.AgentPool, t
 
 func testAccCheckScalrAgentPoolTokenChangedOutside(token *scalr.AccessToken) func() {
 	return func() {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		r, err := scalrClient.AccessTokens.Update(
 			context.Background(),
@@ -187,7 +187,7 @@ func createPool(t *testing.T) scalr.AgentPool {
 }
 
 func testAccCheckScalrAgentPoolTokenDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_agent_pool_token" {
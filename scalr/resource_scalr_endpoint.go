@@ -59,15 +59,16 @@ func resourceScalrEndpoint() *schema.Resource {
 			},
 
 			"environment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 		},
 	}
 }
 
 func resourceScalrEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get attributes.
 	name := d.Get("name").(string)
@@ -111,7 +112,7 @@ func resourceScalrEndpointCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceScalrEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	endpointID := d.Id()
 
 	log.Printf("[DEBUG] Read endpoint with ID: %s", endpointID)
@@ -138,7 +139,7 @@ func resourceScalrEndpointRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceScalrEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	var err error
 	// Create a new options struct.
@@ -166,7 +167,7 @@ func resourceScalrEndpointUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceScalrEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Delete endpoint: %s", d.Id())
 	err := scalrClient.Endpoints.Delete(ctx, d.Id())
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
@@ -56,11 +59,12 @@ func resourceScalrEnvironment() *schema.Resource {
 				},
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ForceNew:     true,
+				ValidateFunc: validateAccountID,
 			},
 			"cloud_credentials": {
 				Type:       schema.TypeList,
@@ -84,7 +88,16 @@ func resourceScalrEnvironment() *schema.Resource {
 			"tag_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTagID,
+				},
+			},
+			"force_destroy": {
+				// No schema default, so that the environments created by earlier versions
+				// of the provider show no diff; an omitted value reads as false.
+				Type:     schema.TypeBool,
+				Optional: true,
 			},
 		},
 	}
@@ -123,7 +136,7 @@ func parsePolicyGroupDefinitions(d *schema.ResourceData) ([]*scalr.PolicyGroup,
 }
 
 func resourceScalrEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
@@ -172,7 +185,7 @@ func resourceScalrEnvironmentCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	environmentID := d.Id()
 
@@ -238,7 +251,7 @@ func resourceScalrEnvironmentRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScalrEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	var err error
 	cloudCredentials, err := parseCloudCredentialDefinitions(d)
@@ -258,15 +271,21 @@ func resourceScalrEnvironmentUpdate(ctx context.Context, d *schema.ResourceData,
 		PolicyGroups:          policyGroups,
 	}
 
-	if defaultProviderConfigurationsI, ok := d.GetOk("default_provider_configurations"); ok {
-		defaultProviderConfigurations := defaultProviderConfigurationsI.(*schema.Set).List()
+	if d.HasChange("default_provider_configurations") {
+		defaultProviderConfigurations := d.Get("default_provider_configurations").(*schema.Set).List()
 		pcfgValues := make([]*scalr.ProviderConfiguration, 0)
 		for _, pcfg := range defaultProviderConfigurations {
 			pcfgValues = append(pcfgValues, &scalr.ProviderConfiguration{ID: pcfg.(string)})
 		}
 		options.DefaultProviderConfigurations = pcfgValues
 	} else {
-		options.DefaultProviderConfigurations = make([]*scalr.ProviderConfiguration, 0)
+		// The defaults may be managed by the scalr_environment_provider_configuration_defaults
+		// or scalr_provider_configuration_default resources, so keep them as is.
+		environment, err := scalrClient.Environments.Read(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("Error reading environment %s: %v", d.Id(), err)
+		}
+		options.DefaultProviderConfigurations = environment.DefaultProviderConfigurations
 	}
 
 	log.Printf("[DEBUG] Update environment: %s", d.Id())
@@ -282,20 +301,25 @@ func resourceScalrEnvironmentUpdate(ctx context.Context, d *schema.ResourceData,
 		tagsToAdd := InterfaceArrToTagRelationArr(newSet.Difference(oldSet).List())
 		tagsToDelete := InterfaceArrToTagRelationArr(oldSet.Difference(newSet).List())
 
+		var tasks []func(ctx context.Context) error
 		if len(tagsToAdd) > 0 {
-			err := scalrClient.EnvironmentTags.Add(ctx, d.Id(), tagsToAdd)
-			if err != nil {
-				return diag.Errorf(
-					"Error adding tags to environment %s: %v", d.Id(), err)
-			}
+			tasks = append(tasks, func(ctx context.Context) error {
+				if err := scalrClient.EnvironmentTags.Add(ctx, d.Id(), tagsToAdd); err != nil {
+					return fmt.Errorf("error adding tags: %v", err)
+				}
+				return nil
+			})
 		}
-
 		if len(tagsToDelete) > 0 {
-			err := scalrClient.EnvironmentTags.Delete(ctx, d.Id(), tagsToDelete)
-			if err != nil {
-				return diag.Errorf(
-					"Error deleting tags from environment %s: %v", d.Id(), err)
-			}
+			tasks = append(tasks, func(ctx context.Context) error {
+				if err := scalrClient.EnvironmentTags.Delete(ctx, d.Id(), tagsToDelete); err != nil {
+					return fmt.Errorf("error deleting tags: %v", err)
+				}
+				return nil
+			})
+		}
+		if errs := runTasksConcurrently(ctx, meta.(*providerMeta).parallelism, tasks); len(errs) != 0 {
+			return concurrencyDiagnostics(fmt.Sprintf("Error updating tags of environment %s", d.Id()), errs)
 		}
 	}
 
@@ -303,9 +327,15 @@ func resourceScalrEnvironmentUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	environmentID := d.Id()
 
+	if d.Get("force_destroy").(bool) {
+		if diags := deleteEnvironmentWorkspaces(ctx, scalrClient, environmentID, meta.(*providerMeta).parallelism); diags.HasError() {
+			return diags
+		}
+	}
+
 	log.Printf("[DEBUG] Delete environment %s", environmentID)
 	err := scalrClient.Environments.Delete(ctx, d.Id())
 	if err != nil {
@@ -318,3 +348,78 @@ func resourceScalrEnvironmentDelete(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+// deleteEnvironmentWorkspaces deletes all workspaces of the environment, running at most
+// parallelism deletions at a time. Workspaces that fail to be deleted don't stop the others
+// from being processed and are reported together as blockers. The errors of the deletions
+// interrupted by the cancellation of the context are reported as well.
+func deleteEnvironmentWorkspaces(
+	ctx context.Context, scalrClient *scalr.Client, environmentID string, parallelism int,
+) diag.Diagnostics {
+	var workspaces []*scalr.Workspace
+	options := scalr.WorkspaceListOptions{Environment: scalr.String(environmentID)}
+	for {
+		wl, err := scalrClient.Workspaces.List(ctx, options)
+		if err != nil {
+			return diag.Errorf("Error deleting environment %s: error retrieving workspaces: %v", environmentID, err)
+		}
+		workspaces = append(workspaces, wl.Items...)
+
+		// Exit the loop when we've seen all pages.
+		if wl.CurrentPage >= wl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = wl.NextPage
+	}
+
+	if len(workspaces) == 0 {
+		return nil
+	}
+	log.Printf("[DEBUG] Force destroy of environment %s: deleting %d workspaces", environmentID, len(workspaces))
+
+	var (
+		mu       sync.Mutex
+		deleted  int
+		blockers []string
+	)
+	errs := runConcurrently(ctx, parallelism, len(workspaces), func(ctx context.Context, i int) error {
+		ws := workspaces[i]
+		err := scalrClient.Workspaces.Delete(ctx, ws.ID)
+		if err != nil && ctx.Err() != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
+			log.Printf("[DEBUG] Force destroy of environment %s: failed to delete workspace %s: %v", environmentID, ws.ID, err)
+			blockers = append(blockers, fmt.Sprintf("workspace %s (%s): %v", ws.Name, ws.ID, err))
+			return nil
+		}
+		deleted++
+		log.Printf("[DEBUG] Force destroy of environment %s: deleted workspace %s (%d/%d)",
+			environmentID, ws.ID, deleted, len(workspaces))
+		return nil
+	})
+	var diags diag.Diagnostics
+	if len(blockers) != 0 {
+		sort.Strings(blockers)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary: fmt.Sprintf(
+				"Error deleting environment %s: %d of %d workspaces could not be deleted",
+				environmentID, len(blockers), len(workspaces),
+			),
+			Detail: "  - " + strings.Join(blockers, "\n  - "),
+		})
+	}
+	if len(errs) != 0 {
+		diags = append(diags, concurrencyDiagnostics(
+			fmt.Sprintf("Error deleting environment %s: error deleting workspaces", environmentID), errs,
+		)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,185 @@
+package scalr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalr/go-scalr"
+)
+
+func resourceScalrEnvironmentProviderConfigurationDefaults() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalrEnvironmentProviderConfigurationDefaultsCreate,
+		ReadContext:   resourceScalrEnvironmentProviderConfigurationDefaultsRead,
+		UpdateContext: resourceScalrEnvironmentProviderConfigurationDefaultsUpdate,
+		DeleteContext: resourceScalrEnvironmentProviderConfigurationDefaultsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEnvironmentID,
+			},
+			"provider_configuration_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateProviderConfigurationID,
+				},
+			},
+		},
+	}
+}
+
+func resourceScalrEnvironmentProviderConfigurationDefaultsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scalrClient := meta.(*providerMeta).client
+	environmentID := d.Get("environment_id").(string)
+
+	pcfgIDs := d.Get("provider_configuration_ids").(*schema.Set).List()
+	if err := setEnvironmentDefaultProviderConfigurations(ctx, scalrClient, environmentID, pcfgIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(environmentID)
+
+	return resourceScalrEnvironmentProviderConfigurationDefaultsRead(ctx, d, meta)
+}
+
+func resourceScalrEnvironmentProviderConfigurationDefaultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scalrClient := meta.(*providerMeta).client
+	environmentID := d.Id()
+
+	log.Printf("[DEBUG] Read default provider configurations of environment: %s", environmentID)
+	environment, err := scalrClient.Environments.Read(ctx, environmentID)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound) {
+			log.Printf("[DEBUG] Environment %s no longer exists", environmentID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading environment %s: %v", environmentID, err)
+	}
+
+	current := make([]string, 0, len(environment.DefaultProviderConfigurations))
+	for _, pcfg := range environment.DefaultProviderConfigurations {
+		current = append(current, pcfg.ID)
+	}
+
+	var diags diag.Diagnostics
+	if v, ok := d.GetOk("provider_configuration_ids"); ok {
+		added, removed := diffStringSets(v.(*schema.Set).List(), current)
+		if len(added) > 0 || len(removed) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Default provider configurations of environment %s were changed outside of this resource", environmentID),
+				Detail: fmt.Sprintf(
+					"Added: [%s], removed: [%s]. The full set of defaults is managed by this resource, "+
+						"so it will be restored on the next apply. Make sure the defaults of this environment are not "+
+						"also managed by the scalr_environment.default_provider_configurations attribute "+
+						"or by scalr_provider_configuration_default resources.",
+					strings.Join(added, ", "), strings.Join(removed, ", "),
+				),
+			})
+		}
+	}
+
+	_ = d.Set("environment_id", environment.ID)
+	_ = d.Set("provider_configuration_ids", current)
+
+	return diags
+}
+
+func resourceScalrEnvironmentProviderConfigurationDefaultsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scalrClient := meta.(*providerMeta).client
+
+	if d.HasChange("provider_configuration_ids") {
+		pcfgIDs := d.Get("provider_configuration_ids").(*schema.Set).List()
+		if err := setEnvironmentDefaultProviderConfigurations(ctx, scalrClient, d.Id(), pcfgIDs); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalrEnvironmentProviderConfigurationDefaultsRead(ctx, d, meta)
+}
+
+func resourceScalrEnvironmentProviderConfigurationDefaultsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scalrClient := meta.(*providerMeta).client
+
+	err := setEnvironmentDefaultProviderConfigurations(ctx, scalrClient, d.Id(), nil)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// setEnvironmentDefaultProviderConfigurations replaces the default provider configurations
+// of the environment, keeping the rest of its relations as is.
+func setEnvironmentDefaultProviderConfigurations(
+	ctx context.Context, scalrClient *scalr.Client, environmentID string, pcfgIDs []interface{},
+) error {
+	resourceScalrProviderConfigurationDefaultMutex.Lock()
+	defer resourceScalrProviderConfigurationDefaultMutex.Unlock()
+
+	environment, err := scalrClient.Environments.Read(ctx, environmentID)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound) {
+			return err
+		}
+		return fmt.Errorf("Error retrieving environment %s: %v", environmentID, err)
+	}
+
+	pcfgs := make([]*scalr.ProviderConfiguration, 0, len(pcfgIDs))
+	for _, id := range pcfgIDs {
+		pcfgs = append(pcfgs, &scalr.ProviderConfiguration{ID: id.(string)})
+	}
+
+	log.Printf("[DEBUG] Set default provider configurations of environment %s: %v", environmentID, pcfgIDs)
+	_, err = scalrClient.Environments.Update(ctx, environmentID, scalr.EnvironmentUpdateOptions{
+		DefaultProviderConfigurations: pcfgs,
+		PolicyGroups:                  environment.PolicyGroups,
+		CloudCredentials:              environment.CloudCredentials,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating default provider configurations of environment %s: %v", environmentID, err)
+	}
+
+	return nil
+}
+
+// diffStringSets returns sorted elements present only in the actual set (added)
+// and only in the expected set (removed).
+func diffStringSets(expected []interface{}, actual []string) (added, removed []string) {
+	expectedSet := make(map[string]bool, len(expected))
+	for _, v := range expected {
+		expectedSet[v.(string)] = true
+	}
+	actualSet := make(map[string]bool, len(actual))
+	for _, v := range actual {
+		actualSet[v] = true
+		if !expectedSet[v] {
+			added = append(added, v)
+		}
+	}
+	for v := range expectedSet {
+		if !actualSet[v] {
+			removed = append(removed, v)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return
+}
@@ -0,0 +1,154 @@
+package scalr
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccEnvironmentProviderConfigurationDefaults_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckEnvironmentProviderConfigurationDefaultsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentProviderConfigurationDefaultsConfig(rInt, "[scalr_provider_configuration.first.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentProviderConfigurationDefaultsCount(
+						"scalr_environment_provider_configuration_defaults.test", 1,
+					),
+					resource.TestCheckResourceAttr(
+						"scalr_environment_provider_configuration_defaults.test", "provider_configuration_ids.#", "1",
+					),
+				),
+			},
+			{
+				Config: testAccEnvironmentProviderConfigurationDefaultsConfig(
+					rInt, "[scalr_provider_configuration.first.id, scalr_provider_configuration.second.id]",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentProviderConfigurationDefaultsCount(
+						"scalr_environment_provider_configuration_defaults.test", 2,
+					),
+					resource.TestCheckResourceAttr(
+						"scalr_environment_provider_configuration_defaults.test", "provider_configuration_ids.#", "2",
+					),
+				),
+			},
+			{
+				ResourceName:      "scalr_environment_provider_configuration_defaults.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDiffStringSets(t *testing.T) {
+	added, removed := diffStringSets([]interface{}{"pcfg-1", "pcfg-2"}, []string{"pcfg-3", "pcfg-2"})
+	if !reflect.DeepEqual(added, []string{"pcfg-3"}) {
+		t.Fatalf("expected added [pcfg-3], got %v", added)
+	}
+	if !reflect.DeepEqual(removed, []string{"pcfg-1"}) {
+		t.Fatalf("expected removed [pcfg-1], got %v", removed)
+	}
+
+	added, removed = diffStringSets([]interface{}{"pcfg-1"}, []string{"pcfg-1"})
+	if len(added) != 0 || len(removed) != 0 {
+		t.Fatalf("expected no difference, got added %v, removed %v", added, removed)
+	}
+}
+
+func testAccCheckEnvironmentProviderConfigurationDefaultsCount(rn string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
+
+		environment, err := scalrClient.Environments.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(environment.DefaultProviderConfigurations) != expected {
+			return fmt.Errorf(
+				"Expected %d default provider configurations, got %d",
+				expected, len(environment.DefaultProviderConfigurations),
+			)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentProviderConfigurationDefaultsDestroy(s *terraform.State) error {
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "scalr_environment_provider_configuration_defaults" {
+			continue
+		}
+
+		environment, err := scalrClient.Environments.Read(ctx, rs.Primary.ID)
+		if err == nil && len(environment.DefaultProviderConfigurations) != 0 {
+			return fmt.Errorf("Environment %s still has default provider configurations", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccEnvironmentProviderConfigurationDefaultsConfig(rInt int, pcfgIDs string) string {
+	return fmt.Sprintf(`
+locals {
+  account_id = "%[1]s"
+}
+
+resource "scalr_environment" "test" {
+  name       = "test-env-%[2]d"
+  account_id = local.account_id
+}
+
+resource "scalr_provider_configuration" "first" {
+  name         = "test-first-%[2]d"
+  account_id   = local.account_id
+  environments = [scalr_environment.test.id]
+  custom {
+    provider_name = "kubernetes"
+    argument {
+      name  = "host"
+      value = "my-host"
+    }
+  }
+}
+
+resource "scalr_provider_configuration" "second" {
+  name         = "test-second-%[2]d"
+  account_id   = local.account_id
+  environments = [scalr_environment.test.id]
+  custom {
+    provider_name = "consul"
+    argument {
+      name  = "address"
+      value = "my-address"
+    }
+  }
+}
+
+resource "scalr_environment_provider_configuration_defaults" "test" {
+  environment_id             = scalr_environment.test.id
+  provider_configuration_ids = %[3]s
+}
+`, defaultAccount, rInt, pcfgIDs)
+}
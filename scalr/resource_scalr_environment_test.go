@@ -1,8 +1,11 @@
 package scalr
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -118,8 +121,91 @@ func TestAccEnvironmentWithProviderConfigurations_update(t *testing.T) {
 	})
 }
 
+func TestAccEnvironment_forceDestroy(t *testing.T) {
+	environment := &scalr.Environment{}
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckScalrEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentForceDestroyConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrEnvironmentExists("scalr_environment.test", environment),
+					resource.TestCheckResourceAttr("scalr_environment.test", "force_destroy", "true"),
+				),
+			},
+			{
+				PreConfig: func() {
+					scalrClient := testAccProvider.Meta().(*providerMeta).client
+					for i := 0; i < 3; i++ {
+						_, err := scalrClient.Workspaces.Create(ctx, scalr.WorkspaceCreateOptions{
+							Name:        scalr.String(fmt.Sprintf("unmanaged-ws-%d", i)),
+							Environment: &scalr.Environment{ID: environment.ID},
+						})
+						if err != nil {
+							t.Fatalf("Error creating workspace: %v", err)
+						}
+					}
+				},
+				Config:  testAccEnvironmentForceDestroyConfig(rInt),
+				Destroy: true,
+			},
+		},
+	})
+}
+
+func TestDeleteEnvironmentWorkspaces(t *testing.T) {
+	client := testScalrClient(t)
+	workspaces := client.Workspaces.(*mockWorkspaces)
+
+	for i, env := range []string{"env-1", "env-1", "env-1", "env-2"} {
+		_, _ = client.Workspaces.Create(context.Background(), scalr.WorkspaceCreateOptions{
+			ID:          fmt.Sprintf("ws-%d", i),
+			Name:        scalr.String(fmt.Sprintf("workspace-%d", i)),
+			Environment: &scalr.Environment{ID: env},
+		})
+	}
+	workspaces.deleteErrors["ws-1"] = errors.New("workspace has active runs")
+
+	diags := deleteEnvironmentWorkspaces(context.Background(), client, "env-1", 2)
+	if len(diags) != 1 {
+		t.Fatalf("expected blockers to be reported, got %#v", diags)
+	}
+	if !strings.Contains(diags[0].Summary, "1 of 3 workspaces could not be deleted") ||
+		!strings.Contains(diags[0].Detail, "workspace workspace-1 (ws-1): workspace has active runs") {
+		t.Fatalf("unexpected diagnostic: %#v", diags[0])
+	}
+
+	remaining, _ := client.Workspaces.List(context.Background(), scalr.WorkspaceListOptions{})
+	var ids []string
+	for _, ws := range remaining.Items {
+		ids = append(ids, ws.ID)
+	}
+	if strings.Join(ids, ",") != "ws-1,ws-3" {
+		t.Fatalf("expected workspaces ws-1 and ws-3 to remain, got %v", ids)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	diags = deleteEnvironmentWorkspaces(cancelled, client, "env-1", 2)
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "context canceled") {
+		t.Fatalf("expected the cancellation to be reported, got %#v", diags)
+	}
+
+	delete(workspaces.deleteErrors, "ws-1")
+	if diags := deleteEnvironmentWorkspaces(context.Background(), client, "env-1", 2); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	if diags := deleteEnvironmentWorkspaces(context.Background(), client, "env-1", 2); diags.HasError() {
+		t.Fatalf("unexpected diagnostics for empty environment: %#v", diags)
+	}
+}
+
 func testAccCheckScalrEnvironmentDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_environment" {
@@ -141,7 +227,7 @@ func testAccCheckScalrEnvironmentDestroy(s *terraform.State) error {
 
 func testAccCheckScalrEnvironmentExists(n string, environment *scalr.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -195,7 +281,7 @@ func testAccCheckScalrEnvironmentAttributesUpdate(environment *scalr.Environment
 
 func testAccCheckScalrEnvironmentProviderConfigurations(environment *scalr.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		if len(environment.DefaultProviderConfigurations) != 1 {
 			return fmt.Errorf("Bad default provider configurations: %v", environment.DefaultProviderConfigurations)
@@ -212,7 +298,7 @@ func testAccCheckScalrEnvironmentProviderConfigurations(environment *scalr.Envir
 }
 func testAccCheckScalrEnvironmentProviderConfigurationsUpdate(environment *scalr.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		if len(environment.DefaultProviderConfigurations) != 1 {
 			return fmt.Errorf("Bad default provider configurations: %v", environment.DefaultProviderConfigurations)
@@ -247,6 +333,15 @@ resource "scalr_environment" "test" {
 }`, rInt, defaultAccount, cloudCredential)
 }
 
+func testAccEnvironmentForceDestroyConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name          = "test-env-%d"
+  account_id    = "%s"
+  force_destroy = true
+}`, rInt, defaultAccount)
+}
+
 func testAccEnvironmentUpdateConfig(rInt int) string {
 	return fmt.Sprintf(`
 resource "scalr_environment" "test" {
@@ -31,22 +31,27 @@ func resourceScalrIamTeam() *schema.Resource {
 				Optional: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ForceNew:     true,
+				ValidateFunc: validateAccountID,
 			},
 			"identity_provider_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIdentityProviderID,
 			},
 			"users": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateUserID,
+				},
 			},
 		},
 	}
@@ -69,7 +74,7 @@ func parseUserDefinitions(d *schema.ResourceData) ([]*scalr.User, error) {
 }
 
 func resourceScalrIamTeamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
@@ -103,7 +108,7 @@ func resourceScalrIamTeamCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScalrIamTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of team %s", id)
@@ -137,7 +142,7 @@ func resourceScalrIamTeamRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceScalrIamTeamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -167,7 +172,7 @@ func resourceScalrIamTeamUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScalrIamTeamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete team %s", id)
@@ -140,7 +140,7 @@ func TestAccScalrIamTeam_import(t *testing.T) {
 
 func testAccCheckScalrIamTeamExists(resId string, team *scalr.Team) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -164,7 +164,7 @@ func testAccCheckScalrIamTeamExists(resId string, team *scalr.Team) resource.Tes
 
 func testAccCheckScalrIamTeamRename(team *scalr.Team) func() {
 	return func() {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		t, err := scalrClient.Teams.Read(ctx, team.ID)
 		if err != nil {
@@ -189,7 +189,7 @@ func testAccCheckScalrIamTeamRename(team *scalr.Team) func() {
 }
 
 func testAccCheckScalrIamTeamDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_iam_team" {
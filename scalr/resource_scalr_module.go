@@ -62,28 +62,31 @@ func resourceScalrModule() *schema.Resource {
 				},
 			},
 			"vcs_provider_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateVcsProviderID,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ForceNew:     true,
+				ValidateFunc: validateAccountID,
 			},
 			"environment_id": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Optional: true,
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 		},
 	}
 }
 
 func resourceScalrModuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	vcsRepo := d.Get("vcs_repo").([]interface{})[0].(map[string]interface{})
 	vcsOpt := &scalr.ModuleVCSRepo{
@@ -116,7 +119,7 @@ func resourceScalrModuleCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceScalrModuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of module: %s", id)
 	m, err := scalrClient.Modules.Read(ctx, id)
@@ -152,7 +155,7 @@ func resourceScalrModuleRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceScalrModuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete module %s", id)
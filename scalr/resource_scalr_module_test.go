@@ -87,7 +87,7 @@ func TestAccScalrModule_import(t *testing.T) {
 
 func testAccCheckScalrModuleExists(moduleId string, module *scalr.Module) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[moduleId]
 		if !ok {
@@ -111,7 +111,7 @@ func testAccCheckScalrModuleExists(moduleId string, module *scalr.Module) resour
 }
 
 func testAccCheckScalrModuleDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_module" {
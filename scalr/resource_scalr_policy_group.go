@@ -3,28 +3,49 @@ package scalr
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scalr/go-scalr"
 )
 
+// policyGroupPollInterval is the interval between the checks of the policy group status.
+const policyGroupPollInterval = 5 * time.Second
+
 func resourceScalrPolicyGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceScalrPolicyGroupCreate,
 		ReadContext:   resourceScalrPolicyGroupRead,
 		UpdateContext: resourceScalrPolicyGroupUpdate,
 		DeleteContext: resourceScalrPolicyGroupDelete,
+		CustomizeDiff: resourceScalrPolicyGroupCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"wait_for_ready": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -37,6 +58,10 @@ func resourceScalrPolicyGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^\d+\.\d+\.\d+$`),
+					"must be an OPA version in the format <MAJOR>.<MINOR>.<PATCH>",
+				),
 			},
 			"vcs_repo": {
 				Type:     schema.TypeList,
@@ -62,15 +87,17 @@ func resourceScalrPolicyGroup() *schema.Resource {
 				},
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ForceNew:     true,
+				ValidateFunc: validateAccountID,
 			},
 			"vcs_provider_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateVcsProviderID,
 			},
 			"policies": {
 				Type:     schema.TypeList,
@@ -97,12 +124,21 @@ func resourceScalrPolicyGroup() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"environment_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateEnvironmentID,
+				},
+			},
 		},
 	}
 }
 
 func resourceScalrPolicyGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get required options
 	name := d.Get("name").(string)
@@ -138,11 +174,28 @@ func resourceScalrPolicyGroupCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(pg.ID)
-	return resourceScalrPolicyGroupRead(ctx, d, meta)
+
+	var diags diag.Diagnostics
+	if envIDs, ok := d.GetOk("environment_ids"); ok {
+		diags = syncPolicyGroupEnvironments(
+			ctx, scalrClient, meta.(*providerMeta).parallelism, pg.ID, nil, nil, envIDs.(*schema.Set).List(),
+		)
+		if diags.HasError() {
+			return append(diags, resourceScalrPolicyGroupRead(ctx, d, meta)...)
+		}
+	}
+
+	if policyGroupWaitForReady(d) {
+		if waitDiags := waitForPolicyGroupReady(ctx, scalrClient, pg.ID, d.Timeout(schema.TimeoutCreate)); waitDiags.HasError() {
+			return append(append(diags, waitDiags...), resourceScalrPolicyGroupRead(ctx, d, meta)...)
+		}
+	}
+
+	return append(diags, resourceScalrPolicyGroupRead(ctx, d, meta)...)
 }
 
 func resourceScalrPolicyGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of policy group %s", id)
@@ -188,15 +241,39 @@ func resourceScalrPolicyGroupRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	_ = d.Set("environments", envs)
+	_ = d.Set("environment_ids", envs)
 
 	return nil
 }
 
 func resourceScalrPolicyGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
+	var diags diag.Diagnostics
+	// An empty set of environments unlinks the policy group from all of them only when it is set explicitly,
+	// as the omitted attribute leaves the links intact.
+	if d.HasChange("environment_ids") &&
+		(d.Get("environment_ids").(*schema.Set).Len() > 0 || isExplicitlyEmpty(d.GetRawConfig(), "environment_ids")) {
+		pg, err := scalrClient.PolicyGroups.Read(ctx, id)
+		if err != nil {
+			return diag.Errorf("error reading configuration of policy group %s: %v", id, err)
+		}
+		linked := make([]string, 0, len(pg.Environments))
+		for _, env := range pg.Environments {
+			linked = append(linked, env.ID)
+		}
+
+		oldEnvIDs, newEnvIDs := d.GetChange("environment_ids")
+		diags = syncPolicyGroupEnvironments(
+			ctx, scalrClient, meta.(*providerMeta).parallelism, id, linked, oldEnvIDs.(*schema.Set).List(), newEnvIDs.(*schema.Set).List(),
+		)
+		if diags.HasError() {
+			return append(diags, resourceScalrPolicyGroupRead(ctx, d, meta)...)
+		}
+	}
+
 	if d.HasChange("name") || d.HasChange("opa_version") ||
 		d.HasChange("vcs_provider_id") || d.HasChange("vcs_repo") {
 
@@ -228,13 +305,19 @@ func resourceScalrPolicyGroupUpdate(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return diag.Errorf("error updating policy group %s: %v", id, err)
 		}
+
+		if policyGroupWaitForReady(d) {
+			if waitDiags := waitForPolicyGroupReady(ctx, scalrClient, id, d.Timeout(schema.TimeoutUpdate)); waitDiags.HasError() {
+				return append(append(diags, waitDiags...), resourceScalrPolicyGroupRead(ctx, d, meta)...)
+			}
+		}
 	}
 
-	return resourceScalrPolicyGroupRead(ctx, d, meta)
+	return append(diags, resourceScalrPolicyGroupRead(ctx, d, meta)...)
 }
 
 func resourceScalrPolicyGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete policy group %s", id)
@@ -249,3 +332,142 @@ func resourceScalrPolicyGroupDelete(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+// resourceScalrPolicyGroupCustomizeDiff plans the removal of all environment links
+// when environment_ids is explicitly set to an empty set. Being optional and computed,
+// the attribute otherwise keeps the links read from the API in this case.
+func resourceScalrPolicyGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !isExplicitlyEmpty(d.GetRawConfig(), "environment_ids") {
+		return nil
+	}
+	if d.Get("environment_ids").(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	return d.SetNew("environment_ids", []string{})
+}
+
+// policyGroupWaitForReady reports whether to wait for the policy group to fetch the policies.
+// Waiting is the default when wait_for_ready is omitted; the attribute has no schema default,
+// so that the policy groups created by earlier versions of the provider show no diff.
+func policyGroupWaitForReady(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return true
+	}
+
+	v := rawConfig.GetAttr("wait_for_ready")
+	if v.IsNull() || !v.IsKnown() {
+		return true
+	}
+	return v.True()
+}
+
+// waitForPolicyGroupReady waits until the policy group finishes fetching the policies from the VCS repository.
+func waitForPolicyGroupReady(
+	ctx context.Context, scalrClient *scalr.Client, id string, timeout time.Duration,
+) diag.Diagnostics {
+	log.Printf("[DEBUG] Wait for policy group %s to fetch the policies", id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"", string(scalr.PolicyGroupStatusFetching)},
+		Target:  []string{string(scalr.PolicyGroupStatusActive), string(scalr.PolicyGroupStatusErrored)},
+		Refresh: func() (interface{}, string, error) {
+			pg, err := scalrClient.PolicyGroups.Read(ctx, id)
+			if err != nil {
+				return nil, "", err
+			}
+			return pg, string(pg.Status), nil
+		},
+		Timeout:      timeout,
+		PollInterval: policyGroupPollInterval,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for policy group %s to fetch the policies: %v", id, err)
+	}
+
+	if pg := result.(*scalr.PolicyGroup); pg.Status == scalr.PolicyGroupStatusErrored {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Policy group %s failed to fetch the policies from the VCS repository", id),
+			Detail:        pg.ErrorMessage,
+			AttributePath: cty.GetAttrPath("vcs_repo"),
+		}}
+	}
+
+	return nil
+}
+
+// syncPolicyGroupEnvironments links the policy group to exactly the expected environments.
+// The new links are created in a single call, the obsolete ones are deleted one by one,
+// all of the calls share the worker pool limited by the provider parallelism.
+// Environments linked since the last refresh, i.e. linked but not known to the state,
+// are reported as conflicting with the links managed by other resources.
+func syncPolicyGroupEnvironments(
+	ctx context.Context, scalrClient *scalr.Client, parallelism int, pgID string, linked []string, known, expected []interface{},
+) diag.Diagnostics {
+	toRemove, toAdd := diffStringSets(expected, linked)
+
+	var diags diag.Diagnostics
+	if len(toRemove) > 0 {
+		conflicting, _ := diffStringSets(known, toRemove)
+		if len(conflicting) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Policy group %s was linked to environments outside of environment_ids", pgID),
+				Detail: fmt.Sprintf(
+					"Environments: [%s]. The links are removed, as environment_ids is authoritative. "+
+						"Make sure these links are not also managed by scalr_policy_group_linkage resources.",
+					strings.Join(conflicting, ", "),
+				),
+				AttributePath: cty.GetAttrPath("environment_ids"),
+			})
+		}
+	}
+
+	var tasks []func(ctx context.Context) error
+	if len(toAdd) > 0 {
+		envs := make([]*scalr.PolicyGroupEnvironment, 0, len(toAdd))
+		for _, envID := range toAdd {
+			envs = append(envs, &scalr.PolicyGroupEnvironment{ID: envID})
+		}
+
+		log.Printf("[DEBUG] Link policy group %s to environments: %v", pgID, toAdd)
+		tasks = append(tasks, func(ctx context.Context) error {
+			err := scalrClient.PolicyGroupEnvironments.Create(ctx, scalr.PolicyGroupEnvironmentsCreateOptions{
+				PolicyGroupID:           pgID,
+				PolicyGroupEnvironments: envs,
+			})
+			if err != nil {
+				return fmt.Errorf("error linking environments %s: %v", strings.Join(toAdd, ", "), err)
+			}
+			return nil
+		})
+	}
+
+	if len(toRemove) > 0 {
+		log.Printf("[DEBUG] Unlink policy group %s from environments: %v", pgID, toRemove)
+		for _, envID := range toRemove {
+			envID := envID
+			tasks = append(tasks, func(ctx context.Context) error {
+				err := scalrClient.PolicyGroupEnvironments.Delete(ctx, scalr.PolicyGroupEnvironmentDeleteOptions{
+					PolicyGroupID: pgID,
+					EnvironmentID: envID,
+				})
+				if err != nil && !errors.Is(err, scalr.ErrResourceNotFound) {
+					return fmt.Errorf("error unlinking environment %s: %v", envID, err)
+				}
+				return nil
+			})
+		}
+	}
+
+	if errs := runTasksConcurrently(ctx, parallelism, tasks); len(errs) != 0 {
+		return append(diags, concurrencyDiagnostics(
+			fmt.Sprintf("error syncing environments of policy group %s", pgID), errs,
+		)...)
+	}
+
+	return diags
+}
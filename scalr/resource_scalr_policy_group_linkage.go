@@ -23,21 +23,23 @@ func resourceScalrPolicyGroupLinkage() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"policy_group_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyGroupID,
 			},
 			"environment_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 		},
 	}
 }
 
 func resourceScalrPolicyGroupLinkageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -56,17 +58,26 @@ func resourceScalrPolicyGroupLinkageImport(ctx context.Context, d *schema.Resour
 }
 
 func resourceScalrPolicyGroupLinkageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	pgID := d.Get("policy_group_id").(string)
 	envID := d.Get("environment_id").(string)
 	id := packPolicyGroupLinkageID(pgID, envID)
 
+	_, _, err := getLinkedResources(ctx, id, scalrClient)
+	if err == nil {
+		return diag.Errorf(
+			"policy group linkage %s already exists, it may be managed by the environment_ids attribute of scalr_policy_group", id,
+		)
+	} else if !errors.Is(err, scalr.ErrResourceNotFound) {
+		return diag.Errorf("error creating policy group linkage %s: %v", id, err)
+	}
+
 	opts := scalr.PolicyGroupEnvironmentsCreateOptions{
 		PolicyGroupID:           pgID,
 		PolicyGroupEnvironments: []*scalr.PolicyGroupEnvironment{{ID: envID}},
 	}
-	err := scalrClient.PolicyGroupEnvironments.Create(ctx, opts)
+	err = scalrClient.PolicyGroupEnvironments.Create(ctx, opts)
 	if err != nil {
 		return diag.Errorf("error creating policy group linkage %s: %v", id, err)
 	}
@@ -76,7 +87,7 @@ func resourceScalrPolicyGroupLinkageCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceScalrPolicyGroupLinkageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -97,7 +108,7 @@ func resourceScalrPolicyGroupLinkageRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceScalrPolicyGroupLinkageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
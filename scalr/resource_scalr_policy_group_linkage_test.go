@@ -77,7 +77,7 @@ func testAccCheckPolicyGroupLinkageExists(
 	environment *scalr.Environment,
 ) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[resID]
 		if !ok {
@@ -101,7 +101,7 @@ func testAccCheckPolicyGroupLinkageExists(
 }
 
 func testAccCheckPolicyGroupLinkageDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_policy_group_linkage" {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -69,6 +70,96 @@ func TestAccPolicyGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccPolicyGroup_errored(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			// TODO: delete skip after SCALRCORE-19891
+			t.Skip("Works with personal token but does not work with github action token.")
+			testVcsAccGithubTokenPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPolicyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyGroupNotExistingPathConfig(rInt),
+				ExpectError: regexp.MustCompile("failed to fetch the policies from the VCS repository"),
+			},
+		},
+	})
+}
+
+func TestAccPolicyGroup_invalidOpaVersion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "scalr_policy_group" "test" {
+  name            = "test-pg"
+  vcs_provider_id = "vcs-xxxxxxxxxx"
+  opa_version     = "latest"
+  vcs_repo {
+    identifier = "Scalr/tf-revizor-fixtures"
+  }
+}`,
+				ExpectError: regexp.MustCompile("must be an OPA version in the format"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func TestAccPolicyGroup_environmentIDs(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			// TODO: delete skip after SCALRCORE-19891
+			t.Skip("Works with personal token but does not work with github action token.")
+			testVcsAccGithubTokenPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPolicyGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyGroupEnvironmentIDsConfig(rInt, "scalr_environment.test1.id, scalr_environment.test2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_policy_group.test", "environment_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"scalr_policy_group.test", "environment_ids.*", "scalr_environment.test1", "id"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"scalr_policy_group.test", "environment_ids.*", "scalr_environment.test2", "id"),
+					resource.TestCheckResourceAttr("scalr_environment.test1", "policy_groups.#", "1"),
+				),
+			},
+			{
+				Config: testAccPolicyGroupEnvironmentIDsConfig(rInt, "scalr_environment.test2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_policy_group.test", "environment_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"scalr_policy_group.test", "environment_ids.*", "scalr_environment.test2", "id"),
+				),
+			},
+			{
+				Config: testAccPolicyGroupEnvironmentIDsConfig(rInt, "scalr_environment.test1.id, scalr_environment.test2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_policy_group.test", "environment_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccPolicyGroupEnvironmentIDsConfig(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scalr_policy_group.test", "environment_ids.#", "0"),
+					resource.TestCheckResourceAttr("scalr_policy_group.test", "environments.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPolicyGroup_update(t *testing.T) {
 	rInt := GetRandomInteger()
 
@@ -267,9 +358,10 @@ func TestAccPolicyGroup_import(t *testing.T) {
 				Config: testAccPolicyGroupBasicConfig(rInt),
 			},
 			{
-				ResourceName:      "scalr_policy_group.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "scalr_policy_group.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_ready"},
 			},
 		},
 	})
@@ -277,7 +369,7 @@ func TestAccPolicyGroup_import(t *testing.T) {
 
 func testAccCheckPolicyGroupExists(resID string, policyGroup *scalr.PolicyGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[resID]
 		if !ok {
@@ -299,7 +391,7 @@ func testAccCheckPolicyGroupExists(resID string, policyGroup *scalr.PolicyGroup)
 }
 
 func testAccCheckPolicyGroupDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_policy_group" {
@@ -321,7 +413,7 @@ func testAccCheckPolicyGroupDestroy(s *terraform.State) error {
 
 func testAccCheckPolicyGroupRename(policyGroup *scalr.PolicyGroup) func() {
 	return func() {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		_, err := scalrClient.PolicyGroups.Update(
 			context.Background(),
@@ -393,3 +485,60 @@ resource "scalr_policy_group" "test" {
 }
 `, rInt, string(scalr.Github), githubToken, defaultAccount, policyGroupVcsRepoID, policyGroupVcsRepoPath)
 }
+
+func testAccPolicyGroupNotExistingPathConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "scalr_vcs_provider" "test" {
+  name     = "test-github-%d"
+  vcs_type = "%s"
+  token    = "%s"
+}
+
+resource "scalr_policy_group" "test" {
+  name            = "test-pg-%[1]d"
+  account_id      = "%[4]s"
+  vcs_provider_id = scalr_vcs_provider.test.id
+  vcs_repo {
+    identifier = "%s"
+    path       = "not/existing/path"
+  }
+}
+`, rInt, string(scalr.Github), githubToken, defaultAccount, policyGroupVcsRepoID)
+}
+
+func testAccPolicyGroupEnvironmentIDsConfig(rInt int, environmentIDs string) string {
+	return fmt.Sprintf(`
+resource "scalr_vcs_provider" "test" {
+  name     = "test-github-%[1]d"
+  vcs_type = "%[2]s"
+  token    = "%[3]s"
+}
+
+resource "scalr_environment" "test1" {
+  name       = "test-env-1-%[1]d"
+  account_id = "%[4]s"
+  lifecycle {
+    ignore_changes = [policy_groups]
+  }
+}
+
+resource "scalr_environment" "test2" {
+  name       = "test-env-2-%[1]d"
+  account_id = "%[4]s"
+  lifecycle {
+    ignore_changes = [policy_groups]
+  }
+}
+
+resource "scalr_policy_group" "test" {
+  name            = "test-pg-%[1]d"
+  account_id      = "%[4]s"
+  vcs_provider_id = scalr_vcs_provider.test.id
+  vcs_repo {
+    identifier = "%[5]s"
+    path       = "%[6]s"
+  }
+  environment_ids = [%[7]s]
+}
+`, rInt, string(scalr.Github), githubToken, defaultAccount, policyGroupVcsRepoID, policyGroupVcsRepoPath, environmentIDs)
+}
@@ -12,8 +12,6 @@ import (
 	"github.com/scalr/go-scalr"
 )
 
-const numParallel = 10
-
 func resourceScalrProviderConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceScalrProviderConfigurationCreate,
@@ -42,11 +40,12 @@ func resourceScalrProviderConfiguration() *schema.Resource {
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ForceNew:     true,
+				ValidateFunc: validateAccountID,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -212,7 +211,7 @@ func resourceScalrProviderConfiguration() *schema.Resource {
 }
 
 func resourceScalrProviderConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
@@ -332,20 +331,20 @@ func resourceScalrProviderConfigurationCreate(ctx context.Context, d *schema.Res
 	d.SetId(providerConfiguration.ID)
 
 	if len(createArgumentOptions) != 0 {
-		_, err = createParameters(ctx, scalrClient, providerConfiguration.ID, &createArgumentOptions)
-		if err != nil {
+		_, errs := createParameters(ctx, scalrClient, meta.(*providerMeta).parallelism, providerConfiguration.ID, createArgumentOptions)
+		if len(errs) != 0 {
 			defer func(ctx context.Context, configurationID string) {
 				_ = scalrClient.ProviderConfigurations.Delete(ctx, configurationID)
 			}(ctx, providerConfiguration.ID)
-			return diag.Errorf(
-				"Error creating provider configuration %s for account %s: %v", name, accountID, err)
+			return concurrencyDiagnostics(
+				fmt.Sprintf("Error creating provider configuration %s for account %s", name, accountID), errs)
 		}
 	}
 	return resourceScalrProviderConfigurationRead(ctx, d, meta)
 }
 
 func resourceScalrProviderConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	providerConfiguration, err := scalrClient.ProviderConfigurations.Read(ctx, id)
@@ -468,7 +467,7 @@ func resourceScalrProviderConfigurationRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceScalrProviderConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -565,17 +564,16 @@ func resourceScalrProviderConfigurationUpdate(ctx context.Context, d *schema.Res
 	if v, ok := d.GetOk("custom"); d.HasChange("custom") && ok {
 		custom := v.([]interface{})[0].(map[string]interface{})
 
-		err := syncArguments(ctx, id, custom, scalrClient)
-		if err != nil {
-			return diag.Errorf(
-				"Error updating provider configuration %s arguments: %v", id, err)
+		if errs := syncArguments(ctx, id, custom, scalrClient, meta.(*providerMeta).parallelism); len(errs) != 0 {
+			return concurrencyDiagnostics(
+				fmt.Sprintf("Error updating provider configuration %s arguments", id), errs)
 		}
 	}
 
 	return resourceScalrProviderConfigurationRead(ctx, d, meta)
 }
 
-func syncArguments(ctx context.Context, providerConfigurationId string, custom map[string]interface{}, client *scalr.Client) error {
+func syncArguments(ctx context.Context, providerConfigurationId string, custom map[string]interface{}, client *scalr.Client, parallelism int) []error {
 	providerName := custom["provider_name"].(string)
 	configArgumentsCreateOptions := make(map[string]scalr.ProviderConfigurationParameterCreateOptions)
 	for _, v := range custom["argument"].(*schema.Set).List() {
@@ -598,16 +596,16 @@ func syncArguments(ctx context.Context, providerConfigurationId string, custom m
 
 	providerConfiguration, err := client.ProviderConfigurations.Read(ctx, providerConfigurationId)
 	if err != nil {
-		return fmt.Errorf(
-			"Error reading provider configuration %s: %v", providerConfigurationId, err)
+		return []error{fmt.Errorf(
+			"Error reading provider configuration %s: %v", providerConfigurationId, err)}
 	}
 
 	if providerName != providerConfiguration.ProviderName {
-		return fmt.Errorf(
+		return []error{fmt.Errorf(
 			"Can't change provider configuration type '%s' to '%s'",
 			providerConfiguration.ProviderName,
 			providerName,
-		)
+		)}
 	}
 
 	currentArguments := make(map[string]scalr.ProviderConfigurationParameter)
@@ -638,31 +636,33 @@ func syncArguments(ctx context.Context, providerConfigurationId string, custom m
 			toDelete = append(toDelete, currentArgument.ID)
 		}
 	}
-	_, _, _, err = changeParameters(
+	_, _, _, errs := changeParameters(
 		ctx,
 		client,
+		parallelism,
 		providerConfigurationId,
 		nil,
 		nil,
-		&toDelete,
+		toDelete,
 	)
-	if err != nil {
-		return err
+	if len(errs) != 0 {
+		return errs
 	}
-	_, _, _, err = changeParameters(
+	_, _, _, errs = changeParameters(
 		ctx,
 		client,
+		parallelism,
 		providerConfigurationId,
-		&toCreate,
-		&toUpdate,
+		toCreate,
+		toUpdate,
 		nil,
 	)
-	return err
+	return errs
 
 }
 
 func resourceScalrProviderConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	err := scalrClient.ProviderConfigurations.Delete(ctx, id)
@@ -677,127 +677,80 @@ func resourceScalrProviderConfigurationDelete(ctx context.Context, d *schema.Res
 	return nil
 }
 
-// changeParameters is used to change parameters for provider configuratio.
+// changeParameters is used to change parameters for provider configuration.
 func changeParameters(
 	ctx context.Context,
 	client *scalr.Client,
+	parallelism int,
 	configurationID string,
-	toCreate *[]scalr.ProviderConfigurationParameterCreateOptions,
-	toUpdate *[]scalr.ProviderConfigurationParameterUpdateOptions,
-	toDelete *[]string,
+	toCreate []scalr.ProviderConfigurationParameterCreateOptions,
+	toUpdate []scalr.ProviderConfigurationParameterUpdateOptions,
+	toDelete []string,
 ) (
 	created []scalr.ProviderConfigurationParameter,
 	updated []scalr.ProviderConfigurationParameter,
 	deleted []string,
-	err error,
+	errs []error,
 ) {
-
-	done := make(chan struct{})
-	defer close(done)
-
-	type result struct {
-		created *scalr.ProviderConfigurationParameter
-		updated *scalr.ProviderConfigurationParameter
-		deleted *string
-		err     error
-	}
-	type task struct {
-		createOption *scalr.ProviderConfigurationParameterCreateOptions
-		updateOption *scalr.ProviderConfigurationParameterUpdateOptions
-		deleteId     *string
-	}
-
-	inputCh := make(chan task)
-	var tasks []task
-
-	if toDelete != nil {
-		for i := range *toDelete {
-			tasks = append(tasks, task{deleteId: &(*toDelete)[i]})
-		}
-	}
-	if toUpdate != nil {
-		for i := range *toUpdate {
-			tasks = append(tasks, task{updateOption: &(*toUpdate)[i]})
-		}
-	}
-	if toCreate != nil {
-		for i := range *toCreate {
-			tasks = append(tasks, task{createOption: &(*toCreate)[i]})
-		}
-	}
-
-	if tasks == nil {
-		return
-	}
-
-	go func() {
-		defer close(inputCh)
-		for _, t := range tasks {
-			select {
-			case inputCh <- t:
-
-			case <-done:
-				return
+	var mu sync.Mutex
+
+	tasks := make([]func(ctx context.Context) error, 0, len(toCreate)+len(toUpdate)+len(toDelete))
+	for _, id := range toDelete {
+		id := id
+		tasks = append(tasks, func(ctx context.Context) error {
+			if err := client.ProviderConfigurationParameters.Delete(ctx, id); err != nil {
+				return fmt.Errorf("error deleting argument %s: %v", id, err)
 			}
-		}
-	}()
-
-	var wg sync.WaitGroup
-	wg.Add(numParallel)
-
-	resultCh := make(chan result)
-
-	for i := 0; i < numParallel; i++ {
-		go func() {
-			for t := range inputCh {
-				if t.createOption != nil {
-					parameter, err := client.ProviderConfigurationParameters.Create(ctx, configurationID, *t.createOption)
-					resultCh <- result{created: parameter, err: err}
-				} else if t.updateOption != nil {
-					parameter, err := client.ProviderConfigurationParameters.Update(ctx, t.updateOption.ID, *t.updateOption)
-					resultCh <- result{updated: parameter, err: err}
-				} else {
-					err := client.ProviderConfigurationParameters.Delete(ctx, *t.deleteId)
-					resultCh <- result{deleted: t.deleteId, err: err}
-				}
+			mu.Lock()
+			deleted = append(deleted, id)
+			mu.Unlock()
+			return nil
+		})
+	}
+	for _, option := range toUpdate {
+		option := option
+		tasks = append(tasks, func(ctx context.Context) error {
+			parameter, err := client.ProviderConfigurationParameters.Update(ctx, option.ID, option)
+			if err != nil {
+				return fmt.Errorf("error updating argument %s: %v", option.ID, err)
 			}
-			wg.Done()
-		}()
+			mu.Lock()
+			updated = append(updated, *parameter)
+			mu.Unlock()
+			return nil
+		})
 	}
-
-	go func() {
-		wg.Wait()
-		close(resultCh)
-	}()
-
-	for result := range resultCh {
-		if result.err != nil {
-			err = result.err
-			break
-		} else if result.created != nil {
-			created = append(created, *result.created)
-		} else if result.updated != nil {
-			updated = append(updated, *result.updated)
-		} else {
-			deleted = append(deleted, *result.deleted)
-		}
+	for _, option := range toCreate {
+		option := option
+		tasks = append(tasks, func(ctx context.Context) error {
+			parameter, err := client.ProviderConfigurationParameters.Create(ctx, configurationID, option)
+			if err != nil {
+				return fmt.Errorf("error creating argument %s: %v", *option.Key, err)
+			}
+			mu.Lock()
+			created = append(created, *parameter)
+			mu.Unlock()
+			return nil
+		})
 	}
 
+	errs = runTasksConcurrently(ctx, parallelism, tasks)
 	return
 }
 
-// createParameters is used to create parameters for provider configuratio.
+// createParameters is used to create parameters for provider configuration.
 func createParameters(
 	ctx context.Context,
 	client *scalr.Client,
+	parallelism int,
 	configurationID string,
-	optionsList *[]scalr.ProviderConfigurationParameterCreateOptions,
+	optionsList []scalr.ProviderConfigurationParameterCreateOptions,
 ) (
 	created []scalr.ProviderConfigurationParameter,
-	err error,
+	errs []error,
 ) {
-	created, _, _, err = changeParameters(
-		ctx, client, configurationID, optionsList, nil, nil,
+	created, _, _, errs = changeParameters(
+		ctx, client, parallelism, configurationID, optionsList, nil, nil,
 	)
 	return
 }
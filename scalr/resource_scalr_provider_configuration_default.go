@@ -25,21 +25,23 @@ func resourceScalrProviderConfigurationDefault() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"provider_configuration_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateProviderConfigurationID,
 			},
 			"environment_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 		},
 	}
 }
 
 func resourceScalrProviderConfigurationDefaultImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -60,7 +62,7 @@ func resourceScalrProviderConfigurationDefaultImport(ctx context.Context, d *sch
 func resourceScalrProviderConfigurationDefaultCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	resourceScalrProviderConfigurationDefaultMutex.Lock()
 	defer resourceScalrProviderConfigurationDefaultMutex.Unlock()
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	providerConfigurationID := d.Get("provider_configuration_id").(string)
 	environmentID := d.Get("environment_id").(string)
@@ -104,7 +106,7 @@ func resourceScalrProviderConfigurationDefaultCreate(ctx context.Context, d *sch
 }
 
 func resourceScalrProviderConfigurationDefaultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -134,7 +136,7 @@ func resourceScalrProviderConfigurationDefaultRead(ctx context.Context, d *schem
 func resourceScalrProviderConfigurationDefaultDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	resourceScalrProviderConfigurationDefaultMutex.Lock()
 	defer resourceScalrProviderConfigurationDefaultMutex.Unlock()
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	providerConfigurationID := d.Get("provider_configuration_id").(string)
 	environmentID := d.Get("environment_id").(string)
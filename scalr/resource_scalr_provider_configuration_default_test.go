@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/scalr/go-scalr"
 )
 
 func TestAccProviderConfigurationDefault_basic(t *testing.T) {
@@ -62,7 +61,7 @@ func testAccCheckProviderConfigurationDefaultExists(
 			return fmt.Errorf("Not found: %s", rn)
 		}
 
-		client := testAccProvider.Meta().(*scalr.Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		providerConfigurationID := rs.Primary.Attributes["provider_configuration_id"]
 		environmentID := rs.Primary.Attributes["environment_id"]
@@ -83,7 +82,7 @@ func testAccCheckProviderConfigurationDefaultExists(
 }
 
 func testAccCheckProviderConfigurationDefaultDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_provider_configuration_default" {
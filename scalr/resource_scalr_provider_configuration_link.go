@@ -0,0 +1,129 @@
+package scalr
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scalr/go-scalr"
+)
+
+func resourceScalrProviderConfigurationLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalrProviderConfigurationLinkCreate,
+		ReadContext:   resourceScalrProviderConfigurationLinkRead,
+		UpdateContext: resourceScalrProviderConfigurationLinkUpdate,
+		DeleteContext: resourceScalrProviderConfigurationLinkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWorkspaceID,
+			},
+			"provider_configuration_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateProviderConfigurationID,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+		},
+	}
+}
+
+func resourceScalrProviderConfigurationLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scalrClient := meta.(*providerMeta).client
+
+	workspaceID := d.Get("workspace_id").(string)
+	pcfgID := d.Get("provider_configuration_id").(string)
+
+	options := scalr.ProviderConfigurationLinkCreateOptions{
+		ProviderConfiguration: &scalr.ProviderConfiguration{ID: pcfgID},
+	}
+	if alias := d.Get("alias").(string); alias != "" {
+		options.Alias = scalr.String(alias)
+	}
+
+	log.Printf("[DEBUG] Create provider configuration link %s for workspace %s", pcfgID, workspaceID)
+	link, err := scalrClient.ProviderConfigurationLinks.Create(ctx, workspaceID, options)
+	if err != nil {
+		return diag.Errorf(
+			"Error creating provider configuration link %s for workspace %s: %v", pcfgID, workspaceID, err)
+	}
+
+	d.SetId(link.ID)
+
+	return resourceScalrProviderConfigurationLinkRead(ctx, d, meta)
+}
+
+func resourceScalrProviderConfigurationLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scalrClient := meta.(*providerMeta).client
+	id := d.Id()
+
+	log.Printf("[DEBUG] Read provider configuration link: %s", id)
+	link, err := scalrClient.ProviderConfigurationLinks.Read(ctx, id)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound) {
+			log.Printf("[DEBUG] Provider configuration link %s no longer exists", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading provider configuration link %s: %v", id, err)
+	}
+
+	if link.Workspace == nil {
+		return diag.Errorf("Provider configuration link %s is not linked to a workspace", id)
+	}
+
+	_ = d.Set("workspace_id", link.Workspace.ID)
+	_ = d.Set("provider_configuration_id", link.ProviderConfiguration.ID)
+	_ = d.Set("alias", link.Alias)
+
+	return nil
+}
+
+func resourceScalrProviderConfigurationLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scalrClient := meta.(*providerMeta).client
+	id := d.Id()
+
+	if d.HasChange("alias") {
+		options := scalr.ProviderConfigurationLinkUpdateOptions{
+			Alias: scalr.String(d.Get("alias").(string)),
+		}
+
+		log.Printf("[DEBUG] Update provider configuration link: %s", id)
+		_, err := scalrClient.ProviderConfigurationLinks.Update(ctx, id, options)
+		if err != nil {
+			return diag.Errorf("Error updating provider configuration link %s: %v", id, err)
+		}
+	}
+
+	return resourceScalrProviderConfigurationLinkRead(ctx, d, meta)
+}
+
+func resourceScalrProviderConfigurationLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scalrClient := meta.(*providerMeta).client
+	id := d.Id()
+
+	log.Printf("[DEBUG] Delete provider configuration link: %s", id)
+	err := scalrClient.ProviderConfigurationLinks.Delete(ctx, id)
+	if err != nil {
+		if errors.Is(err, scalr.ErrResourceNotFound) {
+			return nil
+		}
+		return diag.Errorf("Error deleting provider configuration link %s: %v", id, err)
+	}
+
+	return nil
+}
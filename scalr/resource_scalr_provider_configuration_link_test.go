@@ -0,0 +1,148 @@
+package scalr
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scalr/go-scalr"
+)
+
+func TestAccProviderConfigurationLink_basic(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProviderConfigurationLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigurationLinkConfig(rInt, "eu"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProviderConfigurationLinkExists("scalr_provider_configuration_link.test"),
+					resource.TestCheckResourceAttrPair(
+						"scalr_provider_configuration_link.test", "workspace_id",
+						"scalr_workspace.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"scalr_provider_configuration_link.test", "provider_configuration_id",
+						"scalr_provider_configuration.test", "id",
+					),
+					resource.TestCheckResourceAttr("scalr_provider_configuration_link.test", "alias", "eu"),
+				),
+			},
+			{
+				Config: testAccProviderConfigurationLinkConfig(rInt, "us"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProviderConfigurationLinkExists("scalr_provider_configuration_link.test"),
+					resource.TestCheckResourceAttr("scalr_provider_configuration_link.test", "alias", "us"),
+				),
+			},
+			{
+				ResourceName:      "scalr_provider_configuration_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigurationLink_invalidIDs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "scalr_provider_configuration_link" "test" {
+  workspace_id              = "env-xxxxxxxxxx"
+  provider_configuration_id = "pcfg-xxxxxxxxxx"
+}`,
+				ExpectError: regexp.MustCompile("expected workspace_id to be the ID of a workspace"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func testAccCheckProviderConfigurationLinkExists(rn string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
+
+		_, err := scalrClient.ProviderConfigurationLinks.Read(ctx, rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckProviderConfigurationLinkDestroy(s *terraform.State) error {
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "scalr_provider_configuration_link" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := scalrClient.ProviderConfigurationLinks.Read(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Provider configuration link %s still exists", rs.Primary.ID)
+		}
+		if !errors.Is(err, scalr.ErrResourceNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccProviderConfigurationLinkConfig(rInt int, alias string) string {
+	return fmt.Sprintf(`
+resource "scalr_environment" "test" {
+  name       = "test-env-%[1]d"
+  account_id = "%[2]s"
+}
+
+resource "scalr_workspace" "test" {
+  name           = "test-ws-%[1]d"
+  environment_id = scalr_environment.test.id
+}
+
+resource "scalr_provider_configuration" "test" {
+  name         = "test-%[1]d"
+  account_id   = "%[2]s"
+  environments = [scalr_environment.test.id]
+  custom {
+    provider_name = "kubernetes"
+    argument {
+      name  = "host"
+      value = "my-host"
+    }
+  }
+}
+
+resource "scalr_provider_configuration_link" "test" {
+  workspace_id              = scalr_workspace.test.id
+  provider_configuration_id = scalr_provider_configuration.test.id
+  alias                     = "%[3]s"
+}
+`, rInt, defaultAccount, alias)
+}
@@ -542,7 +542,7 @@ func testAccCheckProviderConfigurationExists(n string, providerConfiguration *sc
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		providerConfigurationResource, err := scalrClient.ProviderConfigurations.Read(ctx, rs.Primary.ID)
 
@@ -557,7 +557,7 @@ func testAccCheckProviderConfigurationExists(n string, providerConfiguration *sc
 }
 
 func testAccCheckProviderConfigurationResourceDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_provider_configuration" {
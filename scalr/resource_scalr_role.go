@@ -36,11 +36,12 @@ func resourceScalrRole() *schema.Resource {
 				Required: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ForceNew:     true,
+				ValidateFunc: validateAccountID,
 			},
 
 			"is_system": {
@@ -81,7 +82,7 @@ func parsePermissionDefinitions(d *schema.ResourceData) ([]*scalr.Permission, er
 }
 
 func resourceScalrRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get required options
 	name := d.Get("name").(string)
@@ -113,7 +114,7 @@ func resourceScalrRoleCreate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceScalrRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of role: %s", id)
 	role, err := scalrClient.Roles.Read(ctx, id)
@@ -163,7 +164,7 @@ func resourceScalrRoleRead(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceScalrRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -192,7 +193,7 @@ func resourceScalrRoleUpdate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceScalrRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete role %s", id)
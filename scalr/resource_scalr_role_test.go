@@ -136,7 +136,7 @@ func TestAccScalrRole_import(t *testing.T) {
 
 func testAccCheckScalrRoleExists(resId string, role *scalr.Role) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -161,7 +161,7 @@ func testAccCheckScalrRoleExists(resId string, role *scalr.Role) resource.TestCh
 
 func testAccCheckScalrRoleRename(role *scalr.Role) func() {
 	return func() {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		r, err := scalrClient.Roles.Read(ctx, role.ID)
 
@@ -185,7 +185,7 @@ func testAccCheckScalrRoleRename(role *scalr.Role) func() {
 }
 
 func testAccCheckScalrRoleDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_role" {
@@ -15,24 +15,29 @@ func resourceScalrRunTrigger() *schema.Resource {
 		CreateContext: resourceScalrRunTriggerCreate,
 		DeleteContext: resourceScalrRunTriggerDelete,
 		ReadContext:   resourceScalrRunTriggerRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"downstream_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWorkspaceID,
 			},
 			"upstream_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWorkspaceID,
 			},
 		},
 	}
 }
 
 func resourceScalrRunTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	downstreamID := d.Get("downstream_id").(string)
 	upstreamID := d.Get("upstream_id").(string)
@@ -54,7 +59,7 @@ func resourceScalrRunTriggerCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrRunTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -72,7 +77,7 @@ func resourceScalrRunTriggerDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrRunTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -29,8 +29,28 @@ func TestAccScalrRunTriggersDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccScalrRunTrigger_import(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckRunTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunTriggerConfig(rInt),
+			},
+			{
+				ResourceName:      "scalr_run_trigger.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckRunTriggerDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_run_trigger" {
@@ -77,7 +97,7 @@ resource "scalr_run_trigger" "foobar" {
 
 func testAccCheckRunTriggerExists(n string, runTrigger *scalr.RunTrigger) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -101,7 +121,7 @@ func testAccCheckRunTriggerExists(n string, runTrigger *scalr.RunTrigger) resour
 
 func testAccCheckRunTriggerAttributes(runTrigger *scalr.RunTrigger, environmentName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		environment, ok := s.RootModule().Resources[environmentName]
 		if !ok {
@@ -47,11 +47,12 @@ func resourceScalrServiceAccount() *schema.Resource {
 				),
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ForceNew:     true,
+				ValidateFunc: validateAccountID,
 			},
 			"created_by": {
 				Type:     schema.TypeList,
@@ -78,7 +79,7 @@ func resourceScalrServiceAccount() *schema.Resource {
 }
 
 func resourceScalrServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Read service account: %s", id)
@@ -113,7 +114,7 @@ func resourceScalrServiceAccountRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceScalrServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
@@ -144,7 +145,7 @@ func resourceScalrServiceAccountCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceScalrServiceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -170,7 +171,7 @@ func resourceScalrServiceAccountUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceScalrServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete service account %s", id)
@@ -112,7 +112,7 @@ resource scalr_service_account test {
 }
 
 func testAccCheckScalrServiceAccountDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_service_account" {
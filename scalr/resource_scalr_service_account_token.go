@@ -18,9 +18,10 @@ func resourceScalrServiceAccountToken() *schema.Resource {
 		DeleteContext: resourceScalrServiceAccountTokenDelete,
 		Schema: map[string]*schema.Schema{
 			"service_account_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateServiceAccountID,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -36,7 +37,7 @@ func resourceScalrServiceAccountToken() *schema.Resource {
 }
 
 func resourceScalrServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	saID := d.Get("service_account_id").(string)
 
@@ -61,7 +62,7 @@ func resourceScalrServiceAccountTokenCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceScalrServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 	saID := d.Get("service_account_id").(string)
 
@@ -106,7 +107,7 @@ func resourceScalrServiceAccountTokenRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceScalrServiceAccountTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -129,7 +130,7 @@ func resourceScalrServiceAccountTokenUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceScalrServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete service account access token %s", id)
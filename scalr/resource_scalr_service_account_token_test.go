@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/scalr/go-scalr"
 )
 
 func TestAccScalrServiceAccountToken_basic(t *testing.T) {
@@ -59,7 +58,7 @@ func TestAccScalrServiceAccountToken_update(t *testing.T) {
 }
 
 func testAccCheckScalrServiceAccountTokenDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_service_account_token" {
@@ -135,7 +135,7 @@ resource scalr_tag test {
 
 func testAccCheckScalrTagRename(tag *scalr.Tag) func() {
 	return func() {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		t, err := scalrClient.Tags.Read(ctx, tag.ID)
 
@@ -161,7 +161,7 @@ func testAccCheckScalrTagRename(tag *scalr.Tag) func() {
 
 func testAccCheckScalrTagExists(resId string, tag *scalr.Tag) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[resId]
 		if !ok {
@@ -185,7 +185,7 @@ func testAccCheckScalrTagExists(resId string, tag *scalr.Tag) resource.TestCheck
 }
 
 func testAccCheckScalrTagDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_tag" {
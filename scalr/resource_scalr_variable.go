@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,12 +31,13 @@ func resourceScalrVariable() *schema.Resource {
 				}
 				return nil
 			},
+			resourceScalrVariableCustomizeDiffScope,
 		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceScalrVariableImport,
 		},
 
-		SchemaVersion: 3,
+		SchemaVersion: 4,
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Type:    resourceScalrVariableResourceV0().CoreConfigSchema().ImpliedType(),
@@ -53,6 +55,11 @@ func resourceScalrVariable() *schema.Resource {
 				Upgrade: resourceScalrVariableStateUpgradeV2,
 				Version: 2,
 			},
+			{
+				Type:    resourceScalrVariableResourceV3().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceScalrVariableStateUpgradeV3,
+				Version: 3,
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,32 +118,105 @@ func resourceScalrVariable() *schema.Resource {
 			},
 
 			"workspace_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWorkspaceID,
 			},
 
 			"environment_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEnvironmentID,
+			},
+
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ForceNew:     true,
+				ValidateFunc: validateAccountID,
+			},
+
+			"scope": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
-			},
-
-			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
-				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						variableScopeWorkspace,
+						variableScopeEnvironment,
+						variableScopeAccount,
+					},
+					false,
+				),
 			},
 		},
 	}
 }
 
+const (
+	variableScopeWorkspace   = "workspace"
+	variableScopeEnvironment = "environment"
+	variableScopeAccount     = "account"
+)
+
+// variableScope returns the scope of the variable depending on the most specific owner it has.
+func variableScope(workspaceID, environmentID string) string {
+	switch {
+	case workspaceID != "":
+		return variableScopeWorkspace
+	case environmentID != "":
+		return variableScopeEnvironment
+	default:
+		return variableScopeAccount
+	}
+}
+
+// resourceScalrVariableCustomizeDiffScope checks that the explicitly configured scope
+// matches the set of owner IDs supplied in the configuration.
+func resourceScalrVariableCustomizeDiffScope(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	scope := rawConfig.GetAttr("scope")
+	if scope.IsNull() || !scope.IsKnown() {
+		return nil
+	}
+
+	hasWorkspace := !rawConfig.GetAttr("workspace_id").IsNull()
+	hasEnvironment := !rawConfig.GetAttr("environment_id").IsNull()
+
+	switch scope.AsString() {
+	case variableScopeWorkspace:
+		if !hasWorkspace {
+			return errors.New("Attribute 'workspace_id' is required for variable with scope 'workspace'.")
+		}
+	case variableScopeEnvironment:
+		if !hasEnvironment {
+			return errors.New("Attribute 'environment_id' is required for variable with scope 'environment'.")
+		}
+		if hasWorkspace {
+			return errors.New("Attribute 'workspace_id' cannot be set for variable with scope 'environment'.")
+		}
+	case variableScopeAccount:
+		if hasWorkspace || hasEnvironment {
+			return errors.New("Attributes 'workspace_id' and 'environment_id' cannot be set for variable with scope 'account'.")
+		}
+	}
+
+	return nil
+}
+
 func resourceScalrVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get key and category.
 	key := d.Get("key").(string)
@@ -192,7 +272,7 @@ func resourceScalrVariableCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceScalrVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Read variable: %s", d.Id())
 	variable, err := scalrClient.Variables.Read(ctx, d.Id())
@@ -229,6 +309,15 @@ func resourceScalrVariableRead(ctx context.Context, d *schema.ResourceData, meta
 		_ = d.Set("account_id", variable.Account.ID)
 	}
 
+	var workspaceID, environmentID string
+	if variable.Workspace != nil {
+		workspaceID = variable.Workspace.ID
+	}
+	if variable.Environment != nil {
+		environmentID = variable.Environment.ID
+	}
+	_ = d.Set("scope", variableScope(workspaceID, environmentID))
+
 	// Only set the value if it's not sensitive, as otherwise it will be empty.
 	if !variable.Sensitive {
 		_ = d.Set("value", variable.Value)
@@ -238,7 +327,7 @@ func resourceScalrVariableRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceScalrVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Create a new options struct.
 	options := scalr.VariableUpdateOptions{
@@ -261,7 +350,7 @@ func resourceScalrVariableUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceScalrVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Delete variable: %s", d.Id())
 	err := scalrClient.Variables.Delete(ctx, d.Id())
@@ -274,3 +363,26 @@ func resourceScalrVariableDelete(ctx context.Context, d *schema.ResourceData, me
 
 	return nil
 }
+
+func resourceScalrVariableImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	scalrClient := meta.(*providerMeta).client
+
+	id := d.Id()
+	if !strings.Contains(id, "/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	scopeID, category, key, err := unpackVariableImportID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	variable, err := getVariableByScopeAndKey(ctx, scalrClient, scopeID, category, key)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(variable.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
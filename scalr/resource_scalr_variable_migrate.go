@@ -60,7 +60,7 @@ func resourceScalrVariableResourceV0() *schema.Resource {
 }
 
 func resourceScalrVariableStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	humanID := rawState["workspace_id"].(string)
 	if !strings.ContainsAny(humanID, "|/") {
@@ -240,7 +240,7 @@ func resourceScalrVariableResourceV2() *schema.Resource {
 }
 
 func resourceScalrVariableStateUpgradeV2(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	varID := rawState["id"].(string)
 	//	var, err := scalrClient.variables.ReadByID(varID)
@@ -252,3 +252,89 @@ func resourceScalrVariableStateUpgradeV2(ctx context.Context, rawState map[strin
 	rawState["description"] = variable.Description
 	return rawState, nil
 }
+
+func resourceScalrVariableResourceV3() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"value": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Default:   "",
+				Sensitive: true,
+			},
+
+			"category": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(scalr.CategoryEnv),
+						string(scalr.CategoryTerraform),
+						string(scalr.CategoryShell),
+					},
+					false,
+				),
+			},
+
+			"hcl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"sensitive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"final": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"environment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceScalrVariableStateUpgradeV3(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	workspaceID, _ := rawState["workspace_id"].(string)
+	environmentID, _ := rawState["environment_id"].(string)
+	rawState["scope"] = variableScope(workspaceID, environmentID)
+	return rawState, nil
+}
@@ -29,7 +29,7 @@ func TestResourceScalrVariableStateUpgradeV0(t *testing.T) {
 	})
 
 	expected := testResourceScalrVariableStateDataV1()
-	actual, err := resourceScalrVariableStateUpgradeV0(ctx, testResourceScalrVariableStateDataV0(), client)
+	actual, err := resourceScalrVariableStateUpgradeV0(ctx, testResourceScalrVariableStateDataV0(), &providerMeta{client: client})
 	assertCorrectState(t, err, actual, expected)
 }
 
@@ -68,7 +68,29 @@ func TestResourceScalrVariableStateUpgradeV2(t *testing.T) {
 	client := testScalrClient(t)
 	variable, _ := client.Variables.Create(context.Background(), scalr.VariableCreateOptions{ID: "var-123"})
 	expected := testResourceScalrVariableStateDataDescriptionV2(variable.ID)
-	actual, err := resourceScalrVariableStateUpgradeV2(ctx, testResourceScalrVariableStateDataDescriptionV1(variable.ID), client)
+	actual, err := resourceScalrVariableStateUpgradeV2(ctx, testResourceScalrVariableStateDataDescriptionV1(variable.ID), &providerMeta{client: client})
 	assertCorrectState(t, err, actual, expected)
 
 }
+
+func TestResourceScalrVariableStateUpgradeV3(t *testing.T) {
+	cases := []struct {
+		state map[string]interface{}
+		scope string
+	}{
+		{map[string]interface{}{"workspace_id": "ws-123", "environment_id": "env-123", "account_id": "acc-123"}, "workspace"},
+		{map[string]interface{}{"workspace_id": "", "environment_id": "env-123", "account_id": "acc-123"}, "environment"},
+		{map[string]interface{}{"workspace_id": "", "environment_id": "", "account_id": "acc-123"}, "account"},
+		{map[string]interface{}{"account_id": "acc-123"}, "account"},
+	}
+
+	for _, c := range cases {
+		expected := make(map[string]interface{})
+		for k, v := range c.state {
+			expected[k] = v
+		}
+		expected["scope"] = c.scope
+		actual, err := resourceScalrVariableStateUpgradeV3(ctx, c.state, nil)
+		assertCorrectState(t, err, actual, expected)
+	}
+}
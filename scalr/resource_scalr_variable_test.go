@@ -106,7 +106,13 @@ func TestAccScalrVariable_scopes(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccScalrVariableOnAllScopes(rInt),
-				Check:  testAccCheckScalrVariableOnScopes(variable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalrVariableOnScopes(variable),
+					resource.TestCheckResourceAttr("scalr_variable.on_account_implicit", "scope", "account"),
+					resource.TestCheckResourceAttr("scalr_variable.on_account", "scope", "account"),
+					resource.TestCheckResourceAttr("scalr_variable.on_environment", "scope", "environment"),
+					resource.TestCheckResourceAttr("scalr_variable.on_workspace", "scope", "workspace"),
+				),
 			},
 		},
 	})
@@ -129,6 +135,23 @@ func TestAccScalrVariable_notTerraformOnMultiscope(t *testing.T) {
 	})
 }
 
+func TestAccScalrVariable_scopeMismatch(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckScalrVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScalrVariableScopeMismatch(rInt),
+				ExpectError: regexp.MustCompile("Attribute 'workspace_id' is required for variable with scope 'workspace'."),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
 func TestAccScalrVariable_update(t *testing.T) {
 	rInt := GetRandomInteger()
 	variable := &scalr.Variable{}
@@ -217,12 +240,26 @@ func TestAccScalrVariable_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName: "scalr_variable.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["scalr_variable.test"]
+					return fmt.Sprintf(
+						"%s/%s/%s",
+						rs.Primary.Attributes["workspace_id"],
+						rs.Primary.Attributes["category"],
+						rs.Primary.Attributes["key"],
+					), nil
+				},
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
 func variableFromState(s *terraform.State, n string, v *scalr.Variable) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	rs, ok := s.RootModule().Resources[n]
 	if !ok {
@@ -345,7 +382,7 @@ func testAccCheckScalrVariableAttributesUpdate(
 }
 
 func testAccCheckScalrVariableDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_variable" {
@@ -429,6 +466,22 @@ resource scalr_variable test {
 }`, rInt, defaultAccount)
 }
 
+func testAccScalrVariableScopeMismatch(rInt int) string {
+	return fmt.Sprintf(`
+resource scalr_environment test {
+  name       = "test-env-%[1]d"
+  account_id = "%[2]s"
+}
+
+resource scalr_variable test {
+  key            = "var_on_ws_%[1]d"
+  value          = "test"
+  category       = "shell"
+  scope          = "workspace"
+  environment_id = scalr_environment.test.id
+}`, rInt, defaultAccount)
+}
+
 func testAccScalrVariableOnAllScopes(rInt int) string {
 	return fmt.Sprintf(`
 resource scalr_environment test {
@@ -466,6 +519,7 @@ resource scalr_variable on_workspace {
   key            = "var_on_ws_%[1]d"
   value          = "test"
   category       = "shell"
+  scope          = "workspace"
   account_id     = "%[2]s"
   environment_id = scalr_environment.test.id
   workspace_id   = scalr_workspace.test.id
@@ -63,18 +63,19 @@ func resourceScalrVcsProvider() *schema.Resource {
 				Optional: true,
 			},
 			"account_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				DefaultFunc: scalrAccountIDDefaultFunc,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				DefaultFunc:  scalrAccountIDDefaultFunc,
+				ForceNew:     true,
+				ValidateFunc: validateAccountID,
 			},
 		},
 	}
 }
 
 func resourceScalrVcsProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	// Get attributes.
 	name := d.Get("name").(string)
 	token := d.Get("token").(string)
@@ -108,7 +109,7 @@ func resourceScalrVcsProviderCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceScalrVcsProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	providerID := d.Id()
 
 	log.Printf("[DEBUG] Read vcs provider with ID: %s", providerID)
@@ -128,7 +129,7 @@ func resourceScalrVcsProviderRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScalrVcsProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	// Create a new options' struct.
 	options := scalr.VcsProviderUpdateOptions{
 		Name:  scalr.String(d.Get("name").(string)),
@@ -154,7 +155,7 @@ func resourceScalrVcsProviderUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceVcsProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Delete vcs provider: %s", d.Id())
 	err := scalrClient.VcsProviders.Delete(ctx, d.Id())
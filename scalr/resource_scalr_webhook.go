@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
+	"sort"
 	"strings"
 
+	"github.com/agext/levenshtein"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scalr/go-scalr"
 )
@@ -20,6 +22,10 @@ var (
 	}
 )
 
+// eventSuggestionMaxDistance is the largest edit distance between an invalid event
+// and a known one for the latter to be suggested.
+const eventSuggestionMaxDistance = 3
+
 func resourceScalrWebhook() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceScalrWebhookCreate,
@@ -48,25 +54,40 @@ func resourceScalrWebhook() *schema.Resource {
 			},
 
 			"events": {
-				Type:     schema.TypeList,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(i interface{}, k string) (warnings []string, errs []error) {
+						// Empty values are reported when the events are parsed.
+						if i.(string) == "" {
+							return
+						}
+						if err := validateEventDefinitions(i.(string)); err != nil {
+							errs = append(errs, err)
+						}
+						return
+					},
+				},
 				Required: true,
 			},
 
 			"endpoint_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEndpointID,
 			},
 
 			"workspace_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateWorkspaceID,
 			},
 
 			"environment_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 		},
 	}
@@ -114,16 +135,47 @@ func validateEventDefinitions(eventName string) error {
 	if val, ok := eventDefinitions[eventName]; ok && val {
 		return nil
 	}
-	i := 0
-	eventDefinitionsQuoted := make([]string, len(eventDefinitions))
-	for eventDefinition := range eventDefinitions {
-		eventDefinitionsQuoted[i] = fmt.Sprintf("'%s'", eventDefinition)
-		i++
+
+	names := knownEventDefinitions()
+	eventDefinitionsQuoted := make([]string, len(names))
+	for i, name := range names {
+		eventDefinitionsQuoted[i] = fmt.Sprintf("'%s'", name)
+	}
+
+	if suggestion := suggestEventDefinition(eventName, names); suggestion != "" {
+		return fmt.Errorf(
+			"Invalid value for events '%s'. Did you mean '%s'? Allowed values: %s",
+			eventName, suggestion, strings.Join(eventDefinitionsQuoted, ", "))
 	}
 	return fmt.Errorf(
 		"Invalid value for events '%s'. Allowed values: %s", eventName, strings.Join(eventDefinitionsQuoted, ", "))
 }
 
+// knownEventDefinitions returns the sorted names of the supported webhook events.
+func knownEventDefinitions() []string {
+	names := make([]string, 0, len(eventDefinitions))
+	for name, ok := range eventDefinitions {
+		if ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// suggestEventDefinition returns the known event closest to the given name,
+// or an empty string if none of them is close enough.
+func suggestEventDefinition(eventName string, names []string) string {
+	suggestion := ""
+	bestDistance := eventSuggestionMaxDistance + 1
+	for _, name := range names {
+		if distance := levenshtein.Distance(eventName, name, nil); distance < bestDistance {
+			suggestion, bestDistance = name, distance
+		}
+	}
+	return suggestion
+}
+
 func parseEventDefinitions(d *schema.ResourceData) ([]*scalr.EventDefinition, error) {
 	eventDefinitions := make([]*scalr.EventDefinition, 0)
 
@@ -145,7 +197,7 @@ func parseEventDefinitions(d *schema.ResourceData) ([]*scalr.EventDefinition, er
 }
 
 func resourceScalrWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get attributes.
 	name := d.Get("name").(string)
@@ -186,7 +238,7 @@ func resourceScalrWebhookCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScalrWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get the ID
 	webhookID := d.Id()
@@ -227,7 +279,7 @@ func resourceScalrWebhookRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceScalrWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	eventDefinitions, err := parseEventDefinitions(d)
 	if err != nil {
@@ -252,7 +304,7 @@ func resourceScalrWebhookUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScalrWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Delete webhook: %s", d.Id())
 	err := scalrClient.Webhooks.Delete(ctx, d.Id())
@@ -3,6 +3,7 @@ package scalr
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -69,6 +70,10 @@ func TestAccWebhook_update(t *testing.T) {
 				Config:      testAccWebhookConfigUpdateEmptyEvent(rInt),
 				ExpectError: regexp.MustCompile("Got error during parsing events: 0-th value is empty"),
 			},
+			{
+				Config:      testAccWebhookConfigUpdateMistypedEvent(rInt),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("Invalid value for events 'run:complete'. Did you mean 'run:completed'?")),
+			},
 		},
 	})
 }
@@ -171,3 +176,42 @@ data scalr_webhook test {
   id         = scalr_webhook.test.id
 }`, rInt, defaultAccount)
 }
+
+func testAccWebhookConfigUpdateMistypedEvent(rInt int) string {
+	return strings.Replace(testAccWebhookConfigUpdateEmptyEvent(rInt), `events                = [""]`, `events                = ["run:complete"]`, 1)
+}
+
+func TestValidateEventDefinitions(t *testing.T) {
+	tests := map[string]struct {
+		event string
+		err   string
+	}{
+		"known event": {
+			event: "run:errored",
+		},
+		"mistyped event": {
+			event: "run:complete",
+			err: "Invalid value for events 'run:complete'. Did you mean 'run:completed'? " +
+				"Allowed values: 'run:completed', 'run:errored', 'run:needs_attention'",
+		},
+		"unknown event": {
+			event: "workspace:created",
+			err:   "Invalid value for events 'workspace:created'. Allowed values: 'run:completed', 'run:errored', 'run:needs_attention'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateEventDefinitions(tt.event)
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.err {
+				t.Fatalf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ func resourceScalrWorkspace() *schema.Resource {
 		ReadContext:   resourceScalrWorkspaceRead,
 		UpdateContext: resourceScalrWorkspaceUpdate,
 		DeleteContext: resourceScalrWorkspaceDelete,
+		CustomizeDiff: resourceScalrWorkspaceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,9 +55,10 @@ func resourceScalrWorkspace() *schema.Resource {
 			},
 
 			"environment_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEnvironmentID,
 			},
 
 			"vcs_provider_id": {
@@ -64,15 +66,18 @@ func resourceScalrWorkspace() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"module_version_id"},
 				RequiredWith:  []string{"vcs_repo"},
+				ValidateFunc:  validateVcsProviderID,
 			},
 			"module_version_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"vcs_provider_id", "vcs_repo"},
+				ValidateFunc:  validateModuleVersionID,
 			},
 			"agent_pool_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAgentPoolID,
 			},
 
 			"auto_apply": {
@@ -259,6 +264,10 @@ func resourceScalrWorkspace() *schema.Resource {
 			"provider_configuration": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
+				// Allows to set an empty list to unlink all configurations,
+				// the block syntax is still supported.
+				ConfigMode: schema.SchemaConfigModeAttr,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -275,7 +284,10 @@ func resourceScalrWorkspace() *schema.Resource {
 			"tag_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTagID,
+				},
 			},
 		},
 	}
@@ -298,7 +310,7 @@ func parseTriggerPrefixDefinitions(vcsRepo map[string]interface{}) ([]string, er
 }
 
 func resourceScalrWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	// Get the name, environment_id and vcs_provider_id.
 	name := d.Get("name").(string)
@@ -421,6 +433,7 @@ func resourceScalrWorkspaceCreate(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(workspace.ID)
 
 	if providerConfigurationsI, ok := d.GetOk("provider_configuration"); ok {
+		var tasks []func(ctx context.Context) error
 		for _, v := range providerConfigurationsI.(*schema.Set).List() {
 			pcfg := v.(map[string]interface{})
 			createLinkOption := scalr.ProviderConfigurationLinkCreateOptions{
@@ -429,13 +442,14 @@ func resourceScalrWorkspaceCreate(ctx context.Context, d *schema.ResourceData, m
 			if alias, ok := pcfg["alias"]; ok && len(alias.(string)) > 0 {
 				createLinkOption.Alias = scalr.String(alias.(string))
 			}
-			_, err := scalrClient.ProviderConfigurationLinks.Create(
-				ctx, workspace.ID, createLinkOption,
-			)
-			if err != nil {
-				return diag.Errorf(
-					"Error creating workspace %s provider configuration link: %v", name, err)
-			}
+			tasks = append(tasks, func(ctx context.Context) error {
+				_, err := scalrClient.ProviderConfigurationLinks.Create(ctx, workspace.ID, createLinkOption)
+				return err
+			})
+		}
+		if errs := runTasksConcurrently(ctx, meta.(*providerMeta).parallelism, tasks); len(errs) != 0 {
+			return concurrencyDiagnostics(
+				fmt.Sprintf("Error creating workspace %s provider configuration link", name), errs)
 		}
 	}
 
@@ -443,7 +457,7 @@ func resourceScalrWorkspaceCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceScalrWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 	log.Printf("[DEBUG] Read configuration of workspace: %s", id)
 	workspace, err := scalrClient.Workspaces.ReadByID(ctx, id)
@@ -555,7 +569,7 @@ func resourceScalrWorkspaceRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceScalrWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	id := d.Id()
 
@@ -672,7 +686,17 @@ func resourceScalrWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, m
 				"Error updating workspace %s: %v", id, err)
 		}
 	}
-	if d.HasChange("provider_configuration") {
+	if d.HasChange("provider_configuration") &&
+		(d.Get("provider_configuration").(*schema.Set).Len() > 0 || isExplicitlyEmpty(d.GetRawConfig(), "provider_configuration")) {
+
+		// Only the links recorded in the state are owned by the workspace,
+		// the ones created since the last refresh are left intact.
+		oldLinks, _ := d.GetChange("provider_configuration")
+		ownedLinks := make(map[string]bool)
+		for _, v := range oldLinks.(*schema.Set).List() {
+			configLink := v.(map[string]interface{})
+			ownedLinks[configLink["id"].(string)+configLink["alias"].(string)] = true
+		}
 
 		expectedLinks := make(map[string]scalr.ProviderConfigurationLinkCreateOptions)
 		if providerConfigurationI, ok := d.GetOk("provider_configuration"); ok {
@@ -696,25 +720,34 @@ func resourceScalrWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, m
 			return diag.FromErr(err)
 		}
 
+		var deleteTasks []func(ctx context.Context) error
 		for _, currentLink := range currentLinks {
 			mapID := currentLink.ProviderConfiguration.ID + currentLink.Alias
 			if _, ok := expectedLinks[mapID]; ok {
 				delete(expectedLinks, mapID)
-			} else {
-				err = scalrClient.ProviderConfigurationLinks.Delete(ctx, currentLink.ID)
-				if err != nil {
-					return diag.Errorf(
-						"Error removing provider configuration link in workspace %s: %v", id, err)
-				}
+			} else if ownedLinks[mapID] {
+				linkID := currentLink.ID
+				deleteTasks = append(deleteTasks, func(ctx context.Context) error {
+					return scalrClient.ProviderConfigurationLinks.Delete(ctx, linkID)
+				})
 			}
 		}
-		for _, createOption := range expectedLinks {
-			_, err = scalrClient.ProviderConfigurationLinks.Create(ctx, id, createOption)
-			if err != nil {
-				return diag.Errorf(
-					"Error creating provider configuration link in workspace %s: %v", id, err)
-			}
+		if errs := runTasksConcurrently(ctx, meta.(*providerMeta).parallelism, deleteTasks); len(errs) != 0 {
+			return concurrencyDiagnostics(
+				fmt.Sprintf("Error removing provider configuration link in workspace %s", id), errs)
+		}
 
+		var createTasks []func(ctx context.Context) error
+		for _, createOption := range expectedLinks {
+			createOption := createOption
+			createTasks = append(createTasks, func(ctx context.Context) error {
+				_, err := scalrClient.ProviderConfigurationLinks.Create(ctx, id, createOption)
+				return err
+			})
+		}
+		if errs := runTasksConcurrently(ctx, meta.(*providerMeta).parallelism, createTasks); len(errs) != 0 {
+			return concurrencyDiagnostics(
+				fmt.Sprintf("Error creating provider configuration link in workspace %s", id), errs)
 		}
 	}
 
@@ -725,26 +758,45 @@ func resourceScalrWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, m
 		tagsToAdd := InterfaceArrToTagRelationArr(newSet.Difference(oldSet).List())
 		tagsToDelete := InterfaceArrToTagRelationArr(oldSet.Difference(newSet).List())
 
+		var tasks []func(ctx context.Context) error
 		if len(tagsToAdd) > 0 {
-			err := scalrClient.WorkspaceTags.Add(ctx, id, tagsToAdd)
-			if err != nil {
-				return diag.Errorf(
-					"Error adding tags to workspace %s: %v", id, err)
-			}
+			tasks = append(tasks, func(ctx context.Context) error {
+				if err := scalrClient.WorkspaceTags.Add(ctx, id, tagsToAdd); err != nil {
+					return fmt.Errorf("error adding tags: %v", err)
+				}
+				return nil
+			})
 		}
-
 		if len(tagsToDelete) > 0 {
-			err := scalrClient.WorkspaceTags.Delete(ctx, id, tagsToDelete)
-			if err != nil {
-				return diag.Errorf(
-					"Error deleting tags from workspace %s: %v", id, err)
-			}
+			tasks = append(tasks, func(ctx context.Context) error {
+				if err := scalrClient.WorkspaceTags.Delete(ctx, id, tagsToDelete); err != nil {
+					return fmt.Errorf("error deleting tags: %v", err)
+				}
+				return nil
+			})
+		}
+		if errs := runTasksConcurrently(ctx, meta.(*providerMeta).parallelism, tasks); len(errs) != 0 {
+			return concurrencyDiagnostics(fmt.Sprintf("Error updating tags of workspace %s", id), errs)
 		}
 	}
 
 	return resourceScalrWorkspaceRead(ctx, d, meta)
 }
 
+// resourceScalrWorkspaceCustomizeDiff plans the removal of the provider configuration links
+// when provider_configuration is explicitly set to an empty list. Being optional and computed,
+// the attribute otherwise keeps the links read from the API in this case.
+func resourceScalrWorkspaceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !isExplicitlyEmpty(d.GetRawConfig(), "provider_configuration") {
+		return nil
+	}
+	if d.Get("provider_configuration").(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	return d.SetNew("provider_configuration", []interface{}{})
+}
+
 func getProviderConfigurationWorkspaceLinks(
 	ctx context.Context, scalrClient *scalr.Client, workspaceId string,
 ) (workspaceLinks []*scalr.ProviderConfigurationLink, err error) {
@@ -774,7 +826,7 @@ func getProviderConfigurationWorkspaceLinks(
 }
 
 func resourceScalrWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Delete workspace %s", id)
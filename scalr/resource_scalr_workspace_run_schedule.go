@@ -4,12 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/robfig/cron/v3"
 	"github.com/scalr/go-scalr"
-	"log"
 )
 
+// runScheduleOrderHorizon is the number of days the apply and destroy schedules
+// are compared over when enforce_order is set.
+const runScheduleOrderHorizon = 7
+
 func resourceScalrWorkspaceRunSchedule() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceScalrWorkspaceRunScheduleCreate,
@@ -19,28 +29,64 @@ func resourceScalrWorkspaceRunSchedule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceScalrWorkspaceRunScheduleImport,
 		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("next_apply_at", func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+				return d.HasChange("apply_schedule") || d.HasChange("timezone")
+			}),
+			customdiff.ComputedIf("next_destroy_at", func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+				return d.HasChange("destroy_schedule") || d.HasChange("timezone")
+			}),
+			resourceScalrWorkspaceRunScheduleCustomizeDiffOrder,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateWorkspaceID,
 			},
 			"apply_schedule": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validateCronExpression,
 			},
 			"destroy_schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validateCronExpression,
+			},
+			"timezone": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "",
+				Default:  "UTC",
+				ValidateFunc: func(i interface{}, k string) (warnings []string, errs []error) {
+					if _, err := time.LoadLocation(i.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("expected %s to be a valid IANA time zone name, got %q", k, i))
+					}
+					return
+				},
+			},
+			"enforce_order": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"next_apply_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_destroy_at": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
 }
 
 func resourceScalrWorkspaceRunScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	workspaceId := d.Get("workspace_id").(string)
 
@@ -67,7 +113,7 @@ func resourceScalrWorkspaceRunScheduleCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceScalrWorkspaceRunScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 	workspaceId := d.Id()
 
 	log.Printf("[DEBUG] Read Workspace with ID: %s", workspaceId)
@@ -80,9 +126,23 @@ func resourceScalrWorkspaceRunScheduleRead(ctx context.Context, d *schema.Resour
 		return diag.Errorf("Error retrieving workspace: %v", err)
 	}
 
+	timezone := d.Get("timezone").(string)
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return diag.Errorf("Error loading time zone %s: %v", timezone, err)
+	}
+	now := time.Now()
+
 	// Update the config.
+	_ = d.Set("workspace_id", workspace.ID)
 	_ = d.Set("apply_schedule", workspace.ApplySchedule)
 	_ = d.Set("destroy_schedule", workspace.DestroySchedule)
+	_ = d.Set("timezone", timezone)
+	_ = d.Set("next_apply_at", nextScheduledRunAt(workspace.ApplySchedule, now, location))
+	_ = d.Set("next_destroy_at", nextScheduledRunAt(workspace.DestroySchedule, now, location))
 
 	d.SetId(workspace.ID)
 
@@ -90,7 +150,7 @@ func resourceScalrWorkspaceRunScheduleRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceScalrWorkspaceRunScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	var err error
 	workspaceId := d.Id()
@@ -118,7 +178,7 @@ func resourceScalrWorkspaceRunScheduleUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceScalrWorkspaceRunScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	scalrClient := meta.(*scalr.Client)
+	scalrClient := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Delete run schedules for workspace: %s", d.Id())
 	_, err := scalrClient.Workspaces.SetSchedule(ctx, d.Id(), scalr.WorkspaceRunScheduleOptions{
@@ -135,7 +195,92 @@ func resourceScalrWorkspaceRunScheduleDelete(ctx context.Context, d *schema.Reso
 	return nil
 }
 
+// resourceScalrWorkspaceRunScheduleCustomizeDiffOrder rejects a destroy schedule
+// that runs before the apply schedule on the same day when enforce_order is set.
+func resourceScalrWorkspaceRunScheduleCustomizeDiffOrder(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.Get("enforce_order").(bool) {
+		return nil
+	}
+	if !d.NewValueKnown("apply_schedule") || !d.NewValueKnown("destroy_schedule") {
+		return nil
+	}
+
+	applyExpr := d.Get("apply_schedule").(string)
+	destroyExpr := d.Get("destroy_schedule").(string)
+	if applyExpr == "" || destroyExpr == "" {
+		return nil
+	}
+
+	applySchedule, err := cronScheduleParser.Parse(applyExpr)
+	if err != nil {
+		return fmt.Errorf("Error parsing apply schedule %q: %v", applyExpr, err)
+	}
+	destroySchedule, err := cronScheduleParser.Parse(destroyExpr)
+	if err != nil {
+		return fmt.Errorf("Error parsing destroy schedule %q: %v", destroyExpr, err)
+	}
+
+	return checkRunScheduleOrder(applySchedule, destroySchedule, time.Now())
+}
+
+// checkRunScheduleOrder checks that on every day within the horizon starting from now
+// the first destroy run, if any, does not precede the first apply run of the same day.
+// Days are counted in UTC, as Scalr evaluates the schedules in UTC.
+func checkRunScheduleOrder(applySchedule, destroySchedule cron.Schedule, now time.Time) error {
+	day := now.UTC().Truncate(24 * time.Hour)
+	for i := 0; i < runScheduleOrderHorizon; i++ {
+		nextDay := day.AddDate(0, 0, 1)
+
+		applyAt := applySchedule.Next(day.Add(-time.Second))
+		destroyAt := destroySchedule.Next(day.Add(-time.Second))
+		if applyAt.Before(nextDay) && destroyAt.Before(applyAt) {
+			return fmt.Errorf(
+				"Destroy schedule runs at %s, before the apply schedule runs at %s on the same day (UTC). "+
+					"Adjust the schedules or disable 'enforce_order'.",
+				destroyAt.Format(time.RFC3339), applyAt.Format(time.RFC3339),
+			)
+		}
+
+		day = nextDay
+	}
+
+	return nil
+}
+
+// nextScheduledRunAt returns the time of the next run of the schedule after now in the given location,
+// formatted as RFC 3339, or an empty string if the schedule is not set.
+func nextScheduledRunAt(expr string, now time.Time, location *time.Location) string {
+	if expr == "" {
+		return ""
+	}
+
+	schedule, err := cronScheduleParser.Parse(expr)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to parse run schedule %q: %v", expr, err)
+		return ""
+	}
+
+	return schedule.Next(now.UTC()).In(location).Format(time.RFC3339)
+}
+
 func resourceScalrWorkspaceRunScheduleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	scalrClient := meta.(*providerMeta).client
+
+	// Resolve the <ENVIRONMENT>/<WORKSPACE NAME> import ID, where the environment
+	// is specified either by its ID or by its name.
+	if id := d.Id(); strings.Contains(id, "/") {
+		environment, name, err := unpackWorkspaceID(id)
+		if err != nil {
+			return nil, err
+		}
+
+		workspace, err := getWorkspaceByName(ctx, scalrClient, environment, name)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving workspace %s: %v", id, err)
+		}
+		d.SetId(workspace.ID)
+	}
+
 	err := resourceScalrWorkspaceRunScheduleRead(ctx, d, meta)
 
 	if err != nil {
@@ -2,8 +2,13 @@ package scalr
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestScalrWorkspaceRunSchedule_basic(t *testing.T) {
@@ -42,12 +47,148 @@ func TestScalrWorkspaceRunSchedule_default(t *testing.T) {
 						"scalr_workspace_run_schedule.test", "apply_schedule", "0 22 * * 1-5"),
 					resource.TestCheckResourceAttr(
 						"scalr_workspace_run_schedule.test", "destroy_schedule", ""),
+					resource.TestCheckResourceAttr(
+						"scalr_workspace_run_schedule.test", "timezone", "UTC"),
+					resource.TestCheckResourceAttrSet(
+						"scalr_workspace_run_schedule.test", "next_apply_at"),
+					resource.TestCheckResourceAttr(
+						"scalr_workspace_run_schedule.test", "next_destroy_at", ""),
 				),
 			},
 		},
 	})
 }
 
+func TestScalrWorkspaceRunSchedule_import(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckScalrWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalrWorkspaceRunSchedule(rInt),
+			},
+			{
+				ResourceName:      "scalr_workspace_run_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The attribute only affects the plan and is not stored in Scalr.
+				ImportStateVerifyIgnore: []string{"enforce_order"},
+			},
+			{
+				ResourceName: "scalr_workspace_run_schedule.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					env := s.RootModule().Resources["scalr_environment.test"]
+					return fmt.Sprintf("%s/workspace-run-schedule-test", env.Primary.ID), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"enforce_order"},
+			},
+			{
+				ResourceName:            "scalr_workspace_run_schedule.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("test-env-rs-%d/workspace-run-schedule-test", rInt),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"enforce_order"},
+			},
+		},
+	})
+}
+
+func TestScalrWorkspaceRunSchedule_invalid(t *testing.T) {
+	rInt := GetRandomInteger()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckScalrWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScalrWorkspaceRunScheduleInvalidCron(rInt),
+				ExpectError: regexp.MustCompile("expected apply_schedule to be a valid cron expression"),
+			},
+			{
+				Config:      testAccScalrWorkspaceRunScheduleWrongOrder(rInt),
+				ExpectError: regexp.MustCompile("before the apply schedule runs"),
+			},
+		},
+	})
+}
+
+func TestCheckRunScheduleOrder(t *testing.T) {
+	now := time.Date(2023, time.January, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		apply   string
+		destroy string
+		err     string
+	}{
+		"destroy after apply": {
+			apply:   "0 3 * * *",
+			destroy: "0 4 * * *",
+		},
+		"destroy on days without apply": {
+			apply:   "0 9 * * 1-5",
+			destroy: "0 3 * * 6",
+		},
+		"destroy before apply": {
+			apply:   "0 4 * * *",
+			destroy: "0 3 * * *",
+			err:     "Destroy schedule runs at 2023-01-10T03:00:00Z, before the apply schedule runs at 2023-01-10T04:00:00Z",
+		},
+		"destroy before apply on one day of the week": {
+			apply:   "0 9 * * *",
+			destroy: "0 8 * * 5",
+			err:     "Destroy schedule runs at 2023-01-13T08:00:00Z, before the apply schedule runs at 2023-01-13T09:00:00Z",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			applySchedule, err := cronScheduleParser.Parse(tt.apply)
+			if err != nil {
+				t.Fatal(err)
+			}
+			destroySchedule, err := cronScheduleParser.Parse(tt.destroy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = checkRunScheduleOrder(applySchedule, destroySchedule, now)
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestNextScheduledRunAt(t *testing.T) {
+	now := time.Date(2023, time.January, 10, 12, 0, 0, 0, time.UTC)
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := nextScheduledRunAt("", now, time.UTC); got != "" {
+		t.Fatalf("expected empty value for unset schedule, got %q", got)
+	}
+	if got, want := nextScheduledRunAt("30 3 * * *", now, time.UTC), "2023-01-11T03:30:00Z"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+	if got, want := nextScheduledRunAt("30 3 * * *", now, berlin), "2023-01-11T04:30:00+01:00"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
 const testScalrWorkspaceRunScheduleCommonConfig = `
 resource scalr_environment test {
   name       = "test-env-rs-%d"
@@ -84,3 +225,21 @@ resource scalr_workspace_run_schedule test {
 	apply_schedule = "0 22 * * 1-5"
 }`)
 }
+
+func testAccScalrWorkspaceRunScheduleInvalidCron(rInt int) string {
+	return fmt.Sprintf(testScalrWorkspaceRunScheduleCommonConfig, rInt, defaultAccount, `
+resource scalr_workspace_run_schedule test {
+	workspace_id = scalr_workspace.test.id
+	apply_schedule = "0 25 * * *"
+}`)
+}
+
+func testAccScalrWorkspaceRunScheduleWrongOrder(rInt int) string {
+	return fmt.Sprintf(testScalrWorkspaceRunScheduleCommonConfig, rInt, defaultAccount, `
+resource scalr_workspace_run_schedule test {
+	workspace_id = scalr_workspace.test.id
+	apply_schedule = "0 4 * * *"
+	destroy_schedule = "0 3 * * *"
+	enforce_order = true
+}`)
+}
@@ -353,6 +353,13 @@ func TestAccScalrWorkspace_providerConfiguration(t *testing.T) {
 						"scalr_workspace.test", "provider_configuration.#", "3"),
 				),
 			},
+			{
+				Config: testAccScalrWorkspaceProviderConfigurationEmpty(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"scalr_workspace.test", "provider_configuration.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -375,7 +382,7 @@ func TestAccScalrWorkspace_emptyHooks(t *testing.T) {
 func testAccCheckScalrWorkspaceExists(
 	n string, workspace *scalr.Workspace) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -439,7 +446,7 @@ func testAccCheckScalrWorkspaceMonorepoAttributes(
 func testAccCheckScalrWorkspaceRename(environmentName, workspaceName string) func() {
 	return func() {
 		var environmentID *string
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		listOptions := scalr.EnvironmentListOptions{}
 		envl, err := scalrClient.Environments.List(ctx, listOptions)
@@ -509,7 +516,7 @@ func testAccCheckScalrWorkspaceAttributesUpdated(
 func testAccCheckScalrWorkspaceProviderConfigurations(
 	workspace *scalr.Workspace) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		links, err := getProviderConfigurationWorkspaceLinks(ctx, scalrClient, workspace.ID)
 		if err != nil {
@@ -563,7 +570,7 @@ func testAccCheckScalrWorkspaceProviderConfigurations(
 func testAccCheckScalrWorkspaceProviderConfigurationsUpdated(
 	workspace *scalr.Workspace) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		scalrClient := testAccProvider.Meta().(*scalr.Client)
+		scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 		links, err := getProviderConfigurationWorkspaceLinks(ctx, scalrClient, workspace.ID)
 		if err != nil {
@@ -616,7 +623,7 @@ func testAccCheckScalrWorkspaceProviderConfigurationsUpdated(
 }
 
 func testAccCheckScalrWorkspaceDestroy(s *terraform.State) error {
-	scalrClient := testAccProvider.Meta().(*scalr.Client)
+	scalrClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "scalr_workspace" {
@@ -897,3 +904,43 @@ resource "scalr_workspace" "test" {
 }`, scalr.WorkspaceExecutionModeLocal),
 	)
 }
+
+func testAccScalrWorkspaceProviderConfigurationEmpty(rInt int) string {
+	return fmt.Sprintf(testAccScalrWorkspaceCommonConfig, rInt, defaultAccount,
+		fmt.Sprintf(`
+resource "scalr_provider_configuration" "kubernetes" {
+  name         = "kubernetes"
+  account_id   = scalr_environment.test.account_id
+  environments = ["*"]
+  custom {
+    provider_name = "kubernetes"
+    argument {
+      name  = "config_path"
+      value = "~/.kube/config"
+    }
+  }
+}
+
+resource "scalr_provider_configuration" "consul" {
+  name         = "consul"
+  account_id   = scalr_environment.test.account_id
+  environments = ["*"]
+  custom {
+    provider_name = "consul"
+    argument {
+      name  = "config_path"
+      value = "~/.kube/config"
+	}
+  }
+}
+
+resource "scalr_workspace" "test" {
+  name                   = "workspace-pcfg-test"
+  environment_id         = scalr_environment.test.id
+  auto_apply             = false
+  execution_mode        = "%s"
+  working_directory      = "terraform/test"
+  provider_configuration = []
+}`, scalr.WorkspaceExecutionModeLocal),
+	)
+}
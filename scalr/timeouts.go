@@ -0,0 +1,81 @@
+package scalr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const defaultResourceTimeout = 20 * time.Minute
+
+// defaultResourceTimeouts returns the default timeouts of the operations implemented by the resource.
+func defaultResourceTimeouts(r *schema.Resource) *schema.ResourceTimeout {
+	t := &schema.ResourceTimeout{}
+	if r.CreateContext != nil {
+		t.Create = schema.DefaultTimeout(defaultResourceTimeout)
+	}
+	if r.ReadContext != nil {
+		t.Read = schema.DefaultTimeout(defaultResourceTimeout)
+	}
+	if r.UpdateContext != nil {
+		t.Update = schema.DefaultTimeout(defaultResourceTimeout)
+	}
+	if r.DeleteContext != nil {
+		t.Delete = schema.DefaultTimeout(defaultResourceTimeout)
+	}
+	return t
+}
+
+// withTimeouts allows configuring the timeouts of the resource operations
+// with the `timeouts` block, and reports the operations that did not complete in time.
+func withTimeouts(name string, r *schema.Resource) *schema.Resource {
+	if r.Timeouts == nil {
+		r.Timeouts = defaultResourceTimeouts(r)
+	}
+
+	wrap := func(
+		key, done string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+	) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if fn == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := fn(ctx, d, meta)
+			if !diags.HasError() || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return diags
+			}
+			return timeoutDiagnostics(name, d.Id(), key, done, d.Timeout(key), diags)
+		}
+	}
+
+	r.CreateContext = wrap(schema.TimeoutCreate, "created", r.CreateContext)
+	r.ReadContext = wrap(schema.TimeoutRead, "read", r.ReadContext)
+	r.UpdateContext = wrap(schema.TimeoutUpdate, "updated", r.UpdateContext)
+	r.DeleteContext = wrap(schema.TimeoutDelete, "deleted", r.DeleteContext)
+
+	return r
+}
+
+// timeoutDiagnostics appends a diagnostic explaining what the provider was waiting for
+// to the diagnostics of the operation interrupted by its timeout, which are kept intact.
+func timeoutDiagnostics(
+	name, id, key, done string, timeout time.Duration, diags diag.Diagnostics,
+) diag.Diagnostics {
+	object := name
+	if id != "" {
+		object = fmt.Sprintf("%s %s", name, id)
+	}
+
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Timed out while waiting for %s to be %s", object, done),
+		Detail: fmt.Sprintf(
+			"The operation did not complete within %s. Use the `%s` argument of the `timeouts` block to allow more time.",
+			timeout, key,
+		),
+	})
+}
@@ -0,0 +1,78 @@
+package scalr
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWithTimeouts(t *testing.T) {
+	r := withTimeouts("scalr_test", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			<-ctx.Done()
+			return diag.Errorf("Error deleting test %s: %v", d.Id(), ctx.Err())
+		},
+	})
+
+	if r.Timeouts.Read == nil || r.Timeouts.Delete == nil {
+		t.Fatalf("expected default read and delete timeouts, got %#v", r.Timeouts)
+	}
+	if r.Timeouts.Create != nil || r.Timeouts.Update != nil {
+		t.Fatalf("expected no create and update timeouts, got %#v", r.Timeouts)
+	}
+
+	d := r.TestResourceData()
+	d.SetId("test-123")
+
+	t.Run("reports timed out operation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		diags := r.DeleteContext(ctx, d, nil)
+		if len(diags) != 2 {
+			t.Fatalf("expected the original and the timeout diagnostics, got %#v", diags)
+		}
+		if diags[0].Summary != "Error deleting test test-123: context deadline exceeded" {
+			t.Fatalf("expected the original diagnostic to be kept, got %q", diags[0].Summary)
+		}
+		if diags[1].Summary != "Timed out while waiting for scalr_test test-123 to be deleted" {
+			t.Fatalf("unexpected summary: %q", diags[1].Summary)
+		}
+		if !strings.Contains(diags[1].Detail, "`delete` argument of the `timeouts` block") {
+			t.Fatalf("unexpected detail: %q", diags[1].Detail)
+		}
+	})
+
+	t.Run("keeps attribute paths of the original diagnostics", func(t *testing.T) {
+		diags := timeoutDiagnostics("scalr_test", "test-123", schema.TimeoutCreate, "created", time.Minute, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid name",
+			Detail:        "Name is too long",
+			AttributePath: cty.GetAttrPath("name"),
+		}})
+		if len(diags) != 2 || diags[0].Detail != "Name is too long" || !diags[0].AttributePath.Equals(cty.GetAttrPath("name")) {
+			t.Fatalf("unexpected diagnostics: %#v", diags)
+		}
+	})
+
+	t.Run("leaves cancelled operation intact", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		diags := r.DeleteContext(ctx, d, nil)
+		if len(diags) != 1 || diags[0].Summary != "Error deleting test test-123: context canceled" {
+			t.Fatalf("unexpected diagnostics: %#v", diags)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package scalr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/robfig/cron/v3"
+)
+
+// Prefixes of the Scalr object identifiers.
+const (
+	accountIDPrefix               = "acc-"
+	agentPoolIDPrefix             = "apool-"
+	endpointIDPrefix              = "ep-"
+	environmentIDPrefix           = "env-"
+	identityProviderIDPrefix      = "idp-"
+	moduleVersionIDPrefix         = "modver-"
+	policyGroupIDPrefix           = "pgrp-"
+	providerConfigurationIDPrefix = "pcfg-"
+	roleIDPrefix                  = "role-"
+	serviceAccountIDPrefix        = "sa-"
+	tagIDPrefix                   = "tag-"
+	teamIDPrefix                  = "team-"
+	userIDPrefix                  = "user-"
+	vcsProviderIDPrefix           = "vcs-"
+	workspaceIDPrefix             = "ws-"
+)
+
+var (
+	validateAccountID               = validateID("an account", accountIDPrefix)
+	validateAgentPoolID             = validateID("an agent pool", agentPoolIDPrefix)
+	validateEndpointID              = validateID("an endpoint", endpointIDPrefix)
+	validateEnvironmentID           = validateID("an environment", environmentIDPrefix)
+	validateIdentityProviderID      = validateID("an identity provider", identityProviderIDPrefix)
+	validateModuleVersionID         = validateID("a module version", moduleVersionIDPrefix)
+	validatePolicyGroupID           = validateID("a policy group", policyGroupIDPrefix)
+	validateProviderConfigurationID = validateID("a provider configuration", providerConfigurationIDPrefix)
+	validateRoleID                  = validateID("a role", roleIDPrefix)
+	validateServiceAccountID        = validateID("a service account", serviceAccountIDPrefix)
+	validateTagID                   = validateID("a tag", tagIDPrefix)
+	validateTeamID                  = validateID("a team", teamIDPrefix)
+	validateUserID                  = validateID("a user", userIDPrefix)
+	validateVcsProviderID           = validateID("a VCS provider", vcsProviderIDPrefix)
+	validateWorkspaceID             = validateID("a workspace", workspaceIDPrefix)
+)
+
+// validateID returns a SchemaValidateFunc which checks that the value is an identifier
+// of the given kind of Scalr object, i.e. it starts with the prefix of this kind.
+// Empty values are left to be reported by the resource itself.
+func validateID(kind, prefix string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errs []error) {
+		v, ok := i.(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if v == "" {
+			return
+		}
+
+		if !strings.HasPrefix(v, prefix) || len(v) == len(prefix) {
+			errs = append(errs, fmt.Errorf(
+				"expected %s to be the ID of %s, in the format %s<RANDOM STRING>, got %q", k, kind, prefix, v,
+			))
+		}
+
+		return
+	}
+}
+
+// cronScheduleParser parses the standard five-field cron expressions accepted by Scalr.
+var cronScheduleParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// validateCronExpression checks that the value is a valid five-field cron expression.
+// Empty values are allowed and mean the schedule is not set.
+func validateCronExpression(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if v == "" {
+		return
+	}
+
+	if _, err := cronScheduleParser.Parse(v); err != nil {
+		errs = append(errs, fmt.Errorf("expected %s to be a valid cron expression, got %q: %v", k, v, err))
+	}
+
+	return
+}
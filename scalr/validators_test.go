@@ -0,0 +1,120 @@
+package scalr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateID(t *testing.T) {
+	tests := map[string]struct {
+		value interface{}
+		err   string
+	}{
+		"valid ID": {
+			value: "ws-123",
+		},
+		"empty value": {
+			value: "",
+		},
+		"ID of another kind": {
+			value: "env-123",
+			err:   `expected workspace_id to be the ID of a workspace, in the format ws-<RANDOM STRING>, got "env-123"`,
+		},
+		"prefix only": {
+			value: "ws-",
+			err:   `expected workspace_id to be the ID of a workspace, in the format ws-<RANDOM STRING>, got "ws-"`,
+		},
+		"name instead of ID": {
+			value: "my-workspace",
+			err:   `expected workspace_id to be the ID of a workspace, in the format ws-<RANDOM STRING>, got "my-workspace"`,
+		},
+		"not a string": {
+			value: 1,
+			err:   "expected type of workspace_id to be string",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, errs := validateWorkspaceID(tt.value, "workspace_id")
+			if tt.err == "" {
+				if len(errs) != 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0].Error(), tt.err) {
+				t.Fatalf("expected error %q, got %v", tt.err, errs)
+			}
+		})
+	}
+}
+
+func TestValidateIDKinds(t *testing.T) {
+	for _, tt := range []struct {
+		validate schema.SchemaValidateFunc
+		valid    string
+		invalid  string
+		kind     string
+	}{
+		{validateUserID, "user-123", "team-123", "a user"},
+		{validateTeamID, "team-123", "user-123", "a team"},
+	} {
+		if _, errs := tt.validate(tt.valid, "id"); len(errs) != 0 {
+			t.Fatalf("expected %q to be valid, got %v", tt.valid, errs)
+		}
+		if _, errs := tt.validate(tt.invalid, "id"); len(errs) != 1 || !strings.Contains(errs[0].Error(), "to be the ID of "+tt.kind) {
+			t.Fatalf("expected %q to be invalid ID of %s, got %v", tt.invalid, tt.kind, errs)
+		}
+	}
+}
+
+func TestValidateCronExpression(t *testing.T) {
+	tests := map[string]struct {
+		value interface{}
+		err   string
+	}{
+		"valid expression": {
+			value: "30 3 5 3-5 2",
+		},
+		"steps and lists": {
+			value: "*/15 8-18 * * MON,WED,FRI",
+		},
+		"empty value": {
+			value: "",
+		},
+		"too few fields": {
+			value: "30 3 5 3-5",
+			err:   `expected apply_schedule to be a valid cron expression, got "30 3 5 3-5"`,
+		},
+		"seconds field": {
+			value: "0 30 3 5 3-5 2",
+			err:   `expected apply_schedule to be a valid cron expression, got "0 30 3 5 3-5 2"`,
+		},
+		"out of range": {
+			value: "60 3 * * *",
+			err:   `expected apply_schedule to be a valid cron expression, got "60 3 * * *"`,
+		},
+		"not a string": {
+			value: 1,
+			err:   "expected type of apply_schedule to be string",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, errs := validateCronExpression(tt.value, "apply_schedule")
+			if tt.err == "" {
+				if len(errs) != 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0].Error(), tt.err) {
+				t.Fatalf("expected error %q, got %v", tt.err, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package scalr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/scalr/go-scalr"
+)
+
+// unpackVariableImportID parses the import ID in the format <scope_id>/<category>/<key>,
+// where scope_id is the ID of the workspace, environment or account that owns the variable.
+func unpackVariableImportID(id string) (scopeID string, category scalr.CategoryType, key string, err error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf(
+			"invalid variable import ID format: %s (expected <variable_id> or <scope_id>/<category>/<key>)", id,
+		)
+	}
+
+	scopeID = parts[0]
+	if !strings.HasPrefix(scopeID, "ws-") && !strings.HasPrefix(scopeID, "env-") && !strings.HasPrefix(scopeID, "acc-") {
+		return "", "", "", fmt.Errorf(
+			"invalid variable scope ID %s: expected the ID of a workspace (ws-), environment (env-) or account (acc-)", scopeID,
+		)
+	}
+
+	category = scalr.CategoryType(parts[1])
+	switch category {
+	case scalr.CategoryEnv:
+		// The legacy name of the shell category.
+		category = scalr.CategoryShell
+	case scalr.CategoryShell, scalr.CategoryTerraform:
+	default:
+		return "", "", "", fmt.Errorf(
+			"invalid variable category %s: expected one of %s, %s", parts[1], scalr.CategoryTerraform, scalr.CategoryShell,
+		)
+	}
+
+	return scopeID, category, parts[2], nil
+}
+
+// getVariableByScopeAndKey looks up the variable owned directly by the given workspace,
+// environment or account, i.e. variables inherited from the upper scopes are not considered.
+func getVariableByScopeAndKey(
+	ctx context.Context, scalrClient *scalr.Client, scopeID string, category scalr.CategoryType, key string,
+) (*scalr.Variable, error) {
+	filter := &scalr.VariableFilter{
+		Key:      scalr.String(key),
+		Category: scalr.String(string(category)),
+	}
+	switch {
+	case strings.HasPrefix(scopeID, "ws-"):
+		filter.Workspace = scalr.String(scopeID)
+	case strings.HasPrefix(scopeID, "env-"):
+		filter.Environment = scalr.String(scopeID)
+	default:
+		filter.Account = scalr.String(scopeID)
+	}
+	options := scalr.VariableListOptions{Filter: filter}
+
+	var matched []*scalr.Variable
+	for {
+		vl, err := scalrClient.Variables.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving variables of %s: %v", scopeID, err)
+		}
+
+		for _, v := range vl.Items {
+			if v.Key == key && v.Category == category && variableOwnedBy(v, scopeID) {
+				matched = append(matched, v)
+			}
+		}
+
+		if vl.CurrentPage >= vl.TotalPages {
+			break
+		}
+		options.PageNumber = vl.NextPage
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, fmt.Errorf("%s variable with key '%s' not found in %s", category, key, scopeID)
+	case 1:
+		return matched[0], nil
+	default:
+		ids := make([]string, len(matched))
+		for i, v := range matched {
+			ids[i] = v.ID
+		}
+		return nil, fmt.Errorf(
+			"found more than one %s variable with key '%s' in %s: %s, import it by ID instead",
+			category, key, scopeID, strings.Join(ids, ", "),
+		)
+	}
+}
+
+// variableOwnedBy checks whether the variable is defined exactly on the given scope.
+func variableOwnedBy(v *scalr.Variable, scopeID string) bool {
+	switch {
+	case strings.HasPrefix(scopeID, "ws-"):
+		return v.Workspace != nil && v.Workspace.ID == scopeID
+	case strings.HasPrefix(scopeID, "env-"):
+		return v.Workspace == nil && v.Environment != nil && v.Environment.ID == scopeID
+	default:
+		return v.Workspace == nil && v.Environment == nil && v.Account != nil && v.Account.ID == scopeID
+	}
+}
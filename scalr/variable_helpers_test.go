@@ -0,0 +1,117 @@
+package scalr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/scalr/go-scalr"
+)
+
+func TestUnpackVariableImportID(t *testing.T) {
+	cases := []struct {
+		id       string
+		scopeID  string
+		category scalr.CategoryType
+		key      string
+		err      bool
+	}{
+		{
+			id:       "ws-123/terraform/my_key",
+			scopeID:  "ws-123",
+			category: scalr.CategoryTerraform,
+			key:      "my_key",
+		},
+		{
+			id:       "env-123/env/AWS_REGION",
+			scopeID:  "env-123",
+			category: scalr.CategoryShell,
+			key:      "AWS_REGION",
+		},
+		{
+			id:       "acc-123/shell/FOO",
+			scopeID:  "acc-123",
+			category: scalr.CategoryShell,
+			key:      "FOO",
+		},
+		{
+			id:  "acc-123/shell",
+			err: true,
+		},
+		{
+			id:  "pcfg-123/shell/FOO",
+			err: true,
+		},
+		{
+			id:  "ws-123/unknown/FOO",
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		scopeID, category, key, err := unpackVariableImportID(tc.id)
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error is %t, got %v", tc.id, tc.err, err)
+		}
+
+		if tc.scopeID != scopeID || tc.category != category || tc.key != key {
+			t.Fatalf(
+				"%s: expected %q, %q, %q, got %q, %q, %q",
+				tc.id, tc.scopeID, tc.category, tc.key, scopeID, category, key,
+			)
+		}
+	}
+}
+
+func TestGetVariableByScopeAndKey(t *testing.T) {
+	client := testScalrClient(t)
+
+	acc := &scalr.Account{ID: "acc-123"}
+	env := &scalr.Environment{ID: "env-123"}
+	ws := &scalr.Workspace{ID: "ws-123"}
+	for _, opts := range []scalr.VariableCreateOptions{
+		{ID: "var-acc", Account: acc},
+		{ID: "var-env", Account: acc, Environment: env},
+		{ID: "var-ws", Account: acc, Environment: env, Workspace: ws},
+		{ID: "var-ws-tf", Account: acc, Environment: env, Workspace: ws, Category: scalr.Category(scalr.CategoryTerraform)},
+		{ID: "var-dup-1", Account: acc, Environment: env, Key: scalr.String("DUP")},
+		{ID: "var-dup-2", Account: acc, Environment: env, Key: scalr.String("DUP")},
+	} {
+		if opts.Key == nil {
+			opts.Key = scalr.String("FOO")
+		}
+		if opts.Category == nil {
+			opts.Category = scalr.Category(scalr.CategoryShell)
+		}
+		_, _ = client.Variables.Create(context.Background(), opts)
+	}
+
+	tests := map[string]struct {
+		scopeID  string
+		category scalr.CategoryType
+		key      string
+		want     string
+		err      bool
+	}{
+		"account scope":          {"acc-123", scalr.CategoryShell, "FOO", "var-acc", false},
+		"environment scope":      {"env-123", scalr.CategoryShell, "FOO", "var-env", false},
+		"workspace scope":        {"ws-123", scalr.CategoryShell, "FOO", "var-ws", false},
+		"category is respected":  {"ws-123", scalr.CategoryTerraform, "FOO", "var-ws-tf", false},
+		"missing variable":       {"ws-123", scalr.CategoryShell, "BAR", "", true},
+		"ambiguous variable":     {"env-123", scalr.CategoryShell, "DUP", "", true},
+		"unknown scope variable": {"env-456", scalr.CategoryShell, "FOO", "", true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := getVariableByScopeAndKey(ctx, client, test.scopeID, test.category, test.key)
+
+			if (err != nil) != test.err {
+				t.Fatalf("expected error is %t, got %v", test.err, err)
+			}
+
+			if got != nil && got.ID != test.want {
+				t.Fatalf("wrong result\ngot: %#v\nwant: %#v", got.ID, test.want)
+			}
+		})
+	}
+}
@@ -38,3 +38,78 @@ func unpackWorkspaceID(id string) (environmentID, name string, err error) {
 
 	return s[0], s[1], nil
 }
+
+// getWorkspaceByName returns the workspace with the given name in the environment
+// specified either by its ID or by its name.
+func getWorkspaceByName(ctx context.Context, client *scalr.Client, environment, name string) (*scalr.Workspace, error) {
+	environmentID := environment
+	if !strings.HasPrefix(environment, environmentIDPrefix) {
+		env, err := getEnvironmentByExactName(ctx, client, environment)
+		if err != nil {
+			return nil, err
+		}
+		environmentID = env.ID
+	}
+
+	options := scalr.WorkspaceListOptions{Environment: &environmentID, Name: &name}
+	for {
+		wl, err := client.Workspaces.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving workspaces: %v", err)
+		}
+
+		for _, ws := range wl.Items {
+			if ws.Name == name {
+				return ws, nil
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if wl.CurrentPage >= wl.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = wl.NextPage
+	}
+
+	return nil, fmt.Errorf("Workspace with name '%s' not found in environment %s", name, environmentID)
+}
+
+// getEnvironmentByExactName returns the only environment with the given name
+// among all the environments available to the token.
+func getEnvironmentByExactName(ctx context.Context, client *scalr.Client, name string) (*scalr.Environment, error) {
+	var matched []*scalr.Environment
+
+	options := scalr.EnvironmentListOptions{Name: &name}
+	for {
+		el, err := client.Environments.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving environments: %v", err)
+		}
+
+		// The name filter matches the environments which contain the name, so an exact match is done here.
+		for _, env := range el.Items {
+			if env.Name == name {
+				matched = append(matched, env)
+			}
+		}
+
+		// Exit the loop when we've seen all pages.
+		if el.CurrentPage >= el.TotalPages {
+			break
+		}
+
+		// Update the page number to get the next page.
+		options.PageNumber = el.NextPage
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, fmt.Errorf("Environment with name '%s' not found", name)
+	case 1:
+		return matched[0], nil
+	default:
+		return nil, fmt.Errorf("Found more than one environment with name '%s', use the environment ID instead", name)
+	}
+}
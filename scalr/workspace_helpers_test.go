@@ -53,6 +53,35 @@ func TestFetchWorkspaceID(t *testing.T) {
 	}
 }
 
+func TestGetWorkspaceByName(t *testing.T) {
+	client := testScalrClient(t)
+	for _, ws := range []struct{ id, name, env string }{
+		{"ws-1", "a-workspace", "env-1"},
+		{"ws-2", "a-workspace-2", "env-1"},
+		{"ws-3", "a-workspace", "env-2"},
+	} {
+		name := ws.name
+		_, _ = client.Workspaces.Create(context.Background(), scalr.WorkspaceCreateOptions{
+			ID:          ws.id,
+			Name:        &name,
+			Environment: &scalr.Environment{ID: ws.env},
+		})
+	}
+
+	ws, err := getWorkspaceByName(ctx, client, "env-2", "a-workspace")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ws.ID != "ws-3" {
+		t.Fatalf("expected workspace ws-3, got %s", ws.ID)
+	}
+
+	_, err = getWorkspaceByName(ctx, client, "env-2", "a-workspace-2")
+	if err == nil || err.Error() != "Workspace with name 'a-workspace-2' not found in environment env-2" {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
 func TestUnpackWorkspaceID(t *testing.T) {
 	cases := []struct {
 		id   string